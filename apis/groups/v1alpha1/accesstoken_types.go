@@ -38,22 +38,43 @@ type AccessTokenParameters struct {
 	// +optional
 	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
 
+	// GroupPath is the full path of the group, e.g. my-group/my-subgroup.
+	// It is resolved to the group ID through the GitLab API when GroupID is
+	// not set, which allows referencing groups not managed by Crossplane.
+	// +optional
+	// +immutable
+	GroupPath *string `json:"groupPath,omitempty"`
+
 	// Expiration date of the access token. The date cannot be set later than the maximum allowable lifetime of an access token.
 	// If not set, the maximum allowable lifetime of a personal access token is 365 days.
 	// Expected in ISO 8601 format (2019-03-15T08:00:00Z)
 	// +immutable
 	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 
+	// ExpiresAtPolicy keeps the access token valid by rotating it shortly
+	// before it expires. The rotated token is published to the connection
+	// secret.
+	// +optional
+	ExpiresAtPolicy *ExpiresAtPolicy `json:"expiresAtPolicy,omitempty"`
+
+	// ExpiryWarningDays is the number of days before the expiration date at
+	// which the TokenExpiring condition becomes true. Defaults to 7.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ExpiryWarningDays *int `json:"expiryWarningDays,omitempty"`
+
 	// Access level for the group. Default is 40.
 	// Valid values are 10 (Guest), 20 (Reporter), 30 (Developer), 40 (Maintainer), and 50 (Owner).
 	// +optional
 	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accessLevel is immutable"
 	AccessLevel *AccessLevelValue `json:"accessLevel,omitempty"`
 
 	// Scopes indicates the access token scopes.
 	// Must be at least one of read_repository, read_registry, write_registry,
 	// read_package_registry, or write_package_registry.
 	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="scopes is immutable"
 	Scopes []string `json:"scopes"`
 
 	// Name of the group access token
@@ -61,12 +82,28 @@ type AccessTokenParameters struct {
 	Name string `json:"name"`
 }
 
+// ExpiresAtPolicy defines when and for how long an access token is rotated.
+type ExpiresAtPolicy struct {
+	// RotateDaysBefore is the number of days before the expiration date at
+	// which the access token is rotated.
+	// +kubebuilder:validation:Minimum=1
+	RotateDaysBefore int `json:"rotateDaysBefore"`
+
+	// ValidityDays is the number of days the rotated access token is valid.
+	// +kubebuilder:validation:Minimum=1
+	ValidityDays int `json:"validityDays"`
+}
+
 // AccessTokenObservation represents a access token.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_access_tokens.html
 type AccessTokenObservation struct {
-	TokenID *int `json:"id,omitempty"`
+	TokenID    *int         `json:"id,omitempty"`
+	ExpiresAt  *metav1.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *metav1.Time `json:"lastUsedAt,omitempty"`
+	Active     bool         `json:"active,omitempty"`
+	Revoked    bool         `json:"revoked,omitempty"`
 }
 
 // A AccessTokenSpec defines the desired state of a Gitlab group.
@@ -87,6 +124,8 @@ type AccessTokenStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="EXPIRES AT",type="string",JSONPath=".spec.forProvider.expiresAt"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type AccessToken struct {
@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EpicParameters define the desired state of a Gitlab Epic.
+// Epics are only available in GitLab Premium and Ultimate.
+// https://docs.gitlab.com/ee/api/epics.html
+type EpicParameters struct {
+	// GroupID is the ID of the group to create the epic in.
+	// +optional
+	// +immutable
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId.
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// Title of the epic.
+	Title string `json:"title"`
+
+	// Description of the epic. Limited to 1,048,576 characters.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels of the epic.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// Confidential sets whether the epic should be confidential.
+	// +optional
+	Confidential *bool `json:"confidential,omitempty"`
+
+	// StartDate is the fixed start date of the epic. If not set, the start
+	// date is inherited from the milestones of the epic.
+	// +optional
+	StartDate *metav1.Time `json:"startDate,omitempty"`
+
+	// DueDate is the fixed due date of the epic. If not set, the due date
+	// is inherited from the milestones of the epic.
+	// +optional
+	DueDate *metav1.Time `json:"dueDate,omitempty"`
+
+	// ParentID is the ID of the parent epic.
+	// +optional
+	ParentID *int `json:"parentId,omitempty"`
+
+	// ParentIDRef is a reference to an epic to retrieve its parentId.
+	// +optional
+	ParentIDRef *xpv1.Reference `json:"parentIdRef,omitempty"`
+
+	// ParentIDSelector selects reference to an epic to retrieve its parentId.
+	// +optional
+	ParentIDSelector *xpv1.Selector `json:"parentIdSelector,omitempty"`
+}
+
+// EpicObservation represents the observed state of a Gitlab Epic.
+type EpicObservation struct {
+	ID        int          `json:"id,omitempty"`
+	IID       int          `json:"iid,omitempty"`
+	State     string       `json:"state,omitempty"`
+	WebURL    string       `json:"webUrl,omitempty"`
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+	ClosedAt  *metav1.Time `json:"closedAt,omitempty"`
+}
+
+// An EpicSpec defines the desired state of a Gitlab Epic.
+type EpicSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EpicParameters `json:"forProvider"`
+}
+
+// An EpicStatus represents the observed state of a Gitlab Epic.
+type EpicStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EpicObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Epic is a managed resource that represents a Gitlab group Epic.
+// Its external name is the IID of the epic within the group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="IID",type="integer",JSONPath=".status.atProvider.iid"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.title"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Epic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EpicSpec   `json:"spec"`
+	Status EpicStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EpicList contains a list of Epic items
+type EpicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Epic `json:"items"`
+}
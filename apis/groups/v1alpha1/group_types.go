@@ -24,6 +24,7 @@ import (
 // VisibilityValue represents a visibility level within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/
+// +kubebuilder:validation:Enum=private;internal;public
 type VisibilityValue string
 
 // List of available visibility levels.
@@ -90,6 +91,31 @@ type GroupParameters struct {
 	// +optional
 	ShareWithGroupLock *bool `json:"shareWithGroupLock,omitempty"`
 
+	// Prevent projects in this group from being forked outside of it.
+	// GitLab Premium and Ultimate only.
+	// +optional
+	PreventForkingOutsideGroup *bool `json:"preventForkingOutsideGroup,omitempty"`
+
+	// Prevent this group and its subgroups from being shared with groups
+	// outside of its hierarchy. Top-level groups only.
+	// +optional
+	PreventSharingGroupsOutsideHierarchy *bool `json:"preventSharingGroupsOutsideHierarchy,omitempty" gitlab:"-"`
+
+	// Maximum number of unique projects a user can download within the
+	// interval before it counts as excessive. 0 disables the limit.
+	// Top-level groups on GitLab Ultimate only.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	UniqueProjectDownloadLimit *int `json:"uniqueProjectDownloadLimit,omitempty" gitlab:"-"`
+
+	// Interval in seconds during which uniqueProjectDownloadLimit applies.
+	// 0 disables the limit. Top-level groups on GitLab Ultimate only.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=864000
+	// +optional
+	UniqueProjectDownloadLimitIntervalInSeconds *int `json:"uniqueProjectDownloadLimitIntervalInSeconds,omitempty" gitlab:"-"`
+
 	// Require all users in this group to setup Two-factor authentication.
 	// +optional
 	RequireTwoFactorAuth *bool `json:"requireTwoFactorAuthentication,omitempty"`
@@ -157,7 +183,23 @@ type GroupParameters struct {
 
 	// SharedWithGroups create links for sharing a group with another group.
 	// +optional
-	SharedWithGroups []SharedWithGroups `json:"sharedWithGroups,omitempty"`
+	SharedWithGroups []SharedWithGroups `json:"sharedWithGroups,omitempty" gitlab:"-"`
+
+	// DefaultBranchProtection determines if developers can push to the default
+	// branch of new projects. Can be 0 (not protected), 1 (partially protected),
+	// 2 (fully protected), 3 (protected against pushes) or 4 (fully protected
+	// after initial push).
+	//
+	// Deprecated: Use defaultBranchProtectionDefaults instead. Only use this field
+	// on GitLab versions that do not support defaultBranchProtectionDefaults.
+	// +kubebuilder:validation:Enum=0;1;2;3;4
+	// +optional
+	DefaultBranchProtection *int `json:"defaultBranchProtection,omitempty"`
+
+	// DefaultBranchProtectionDefaults sets the default branch protection of
+	// new projects in this group. Requires GitLab 17.0 or later.
+	// +optional
+	DefaultBranchProtectionDefaults *DefaultBranchProtectionDefaults `json:"defaultBranchProtectionDefaults,omitempty" gitlab:"-"`
 
 	// Force the immediate deletion of the group when removed. In GitLab Premium and Ultimate a group is by default
 	// just marked for deletion and removed permanently after seven days. Defaults to false.
@@ -168,11 +210,41 @@ type GroupParameters struct {
 	// GitLab Premium and Ultimate only.
 	// +optional
 	FullPathToRemove *string `json:"fullPathToRemove,omitempty"`
+
+	// RemoveFinalizerOnPendingDeletion removes the finalizer of a deleted
+	// Group as soon as GitLab has marked the group for deletion, instead of
+	// keeping it until the delayed deletion has been carried out.
+	// +optional
+	RemoveFinalizerOnPendingDeletion *bool `json:"removeFinalizerOnPendingDeletion,omitempty"`
+}
+
+// DefaultBranchProtectionDefaults represents the default branch protection
+// of new projects in a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#options-for-default_branch_protection_defaults
+type DefaultBranchProtectionDefaults struct {
+	// AllowedToPush lists the access levels allowed to push.
+	// +optional
+	AllowedToPush []AccessLevelValue `json:"allowedToPush,omitempty"`
+
+	// AllowForcePush allows force push for all users with push access.
+	// +optional
+	AllowForcePush *bool `json:"allowForcePush,omitempty"`
+
+	// AllowedToMerge lists the access levels allowed to merge.
+	// +optional
+	AllowedToMerge []AccessLevelValue `json:"allowedToMerge,omitempty"`
+
+	// DeveloperCanInitialPush allows developers to push the initial commit.
+	// +optional
+	DeveloperCanInitialPush *bool `json:"developerCanInitialPush,omitempty"`
 }
 
 // AccessLevelValue represents a permission level within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/permissions/permissions.html
+// +kubebuilder:validation:Enum=0;5;10;20;30;40;50
 type AccessLevelValue int
 
 // List of available access levels
@@ -217,6 +289,22 @@ type LDAPGroupLink struct {
 	Provider    string           `json:"provider"`
 }
 
+// AnnotationKeyTriggerLDAPSync can be set on a Group to trigger a sync of its
+// LDAP group links. A sync is requested whenever the value of the annotation
+// changes, e.g. to the current timestamp.
+const AnnotationKeyTriggerLDAPSync = "gitlab.crossplane.io/trigger-ldap-sync"
+
+// LDAPSyncObservation represents the last LDAP sync triggered through the
+// AnnotationKeyTriggerLDAPSync annotation.
+type LDAPSyncObservation struct {
+	// Trigger is the value of the annotation that requested the last sync.
+	Trigger string `json:"trigger,omitempty"`
+
+	// LastSyncTime is the time the last sync was requested. GitLab performs
+	// the sync asynchronously.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
 // SharedWithGroups represents a GitLab Shared with groups.
 // At least one of the fields [GroupID, GroupIDRef, GroupIDSelector] must be set.
 type SharedWithGroups struct {
@@ -254,6 +342,7 @@ type GroupObservation struct {
 	LDAPCN              *string                       `json:"ldapCn,omitempty"`
 	LDAPAccess          *AccessLevelValue             `json:"ldapAccess,omitempty"`
 	LDAPGroupLinks      []LDAPGroupLink               `json:"ldapGroupLinks,omitempty"`
+	LDAPSync            *LDAPSyncObservation          `json:"ldapSync,omitempty"`
 	MarkedForDeletionOn *metav1.Time                  `json:"markedForDeletionOn,omitempty"`
 	CreatedAt           *metav1.Time                  `json:"createdAt,omitempty"`
 	SharedWithGroups    []SharedWithGroupsObservation `json:"sharedWithGroups,omitempty"`
@@ -287,6 +376,7 @@ type GroupStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".status.atProvider.ID"
+// +kubebuilder:printcolumn:name="FULL PATH",type="string",JSONPath=".status.atProvider.fullPath"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type Group struct {
@@ -49,6 +49,13 @@ type MemberParameters struct {
 	// +optional
 	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
 
+	// GroupPath is the full path of the group, e.g. my-group/my-subgroup.
+	// It is resolved to the group ID through the GitLab API when GroupID is
+	// not set, which allows referencing groups not managed by Crossplane.
+	// +optional
+	// +immutable
+	GroupPath *string `json:"groupPath,omitempty"`
+
 	// The user ID of the member.
 	// +optional
 	UserID *int `json:"userID,omitempty"`
@@ -77,6 +77,14 @@ var (
 	AccessTokenGroupVersionKind = SchemeGroupVersion.WithKind(AccessTokenKind)
 )
 
+// Service Account Token type metadata
+var (
+	ServiceAccountTokenKind             = reflect.TypeOf(ServiceAccountToken{}).Name()
+	ServiceAccountTokenGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: ServiceAccountTokenKind}.String()
+	ServiceAccountTokenKindAPIVersion   = ServiceAccountTokenKind + "." + SchemeGroupVersion.String()
+	ServiceAccountTokenGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountTokenKind)
+)
+
 // Variable type metadata
 var (
 	VariableKind             = reflect.TypeOf(Variable{}).Name()
@@ -85,12 +93,31 @@ var (
 	VariableGroupVersionKind = SchemeGroupVersion.WithKind(VariableKind)
 )
 
+// Epic type metadata
+var (
+	EpicKind             = reflect.TypeOf(Epic{}).Name()
+	EpicGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: EpicKind}.String()
+	EpicKindAPIVersion   = EpicKind + "." + SchemeGroupVersion.String()
+	EpicGroupVersionKind = SchemeGroupVersion.WithKind(EpicKind)
+)
+
+// WikiPage type metadata
+var (
+	WikiPageKind             = reflect.TypeOf(WikiPage{}).Name()
+	WikiPageGroupKind        = schema.GroupKind{Group: KubernetesGroup, Kind: WikiPageKind}.String()
+	WikiPageKindAPIVersion   = WikiPageKind + "." + SchemeGroupVersion.String()
+	WikiPageGroupVersionKind = SchemeGroupVersion.WithKind(WikiPageKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Group{}, &GroupList{})
 	SchemeBuilder.Register(&Member{}, &MemberList{})
 	SchemeBuilder.Register(&AccessToken{}, &AccessTokenList{})
+	SchemeBuilder.Register(&ServiceAccountToken{}, &ServiceAccountTokenList{})
 	SchemeBuilder.Register(&DeployToken{}, &DeployTokenList{})
 	SchemeBuilder.Register(&Variable{}, &VariableList{})
 	SchemeBuilder.Register(&SamlGroupLink{}, &SamlGroupLinkList{})
+	SchemeBuilder.Register(&Epic{}, &EpicList{})
+	SchemeBuilder.Register(&WikiPage{}, &WikiPageList{})
 
 }
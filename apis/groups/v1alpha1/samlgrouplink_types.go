@@ -38,6 +38,13 @@ type SamlGroupLinkParameters struct {
 	// +optional
 	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
 
+	// GroupPath is the full path of the group, e.g. my-group/my-subgroup.
+	// It is resolved to the group ID through the GitLab API when GroupID is
+	// not set, which allows referencing groups not managed by Crossplane.
+	// +optional
+	// +immutable
+	GroupPath *string `json:"groupPath,omitempty"`
+
 	// name is the name of the saml group to attach to the gitlab group
 	// +immutable
 	Name *string `json:"name"`
@@ -46,15 +53,18 @@ type SamlGroupLinkParameters struct {
 	// +immutable
 	AccessLevel AccessLevelValue `json:"accessLevel"`
 
-	// memberRoleID is the defined member role assigned to members of the group
+	// memberRoleID is the ID of the custom member role assigned to members
+	// of the SAML group. Custom roles are only available on GitLab Ultimate.
+	// GitLab does not allow updating SAML group links, so the link is
+	// recreated when the member role drifts.
 	// +optional
-	// +immutable
 	MemberRoleID *int `json:"memberRoleId,omitempty"`
 }
 
 // SamlGroupLinkObservation represents a Group Saml Link.
 type SamlGroupLinkObservation struct {
-	Name string `json:"name,omitempty"`
+	Name         string `json:"name,omitempty"`
+	MemberRoleID int    `json:"memberRoleId,omitempty"`
 }
 
 // A SamlGroupLinkSpec defines the desired state of a Gitlab SAML group sync.
@@ -76,6 +86,7 @@ type SamlGroupLinkStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".status.atProvider.name"
+// +kubebuilder:printcolumn:name="ACCESS LEVEL",type="integer",JSONPath=".spec.forProvider.accessLevel"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type SamlGroupLink struct {
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceAccountTokenParameters define the desired state of a personal access
+// token of a Gitlab group service account. Service accounts require Gitlab
+// 16.6 or later.
+// https://docs.gitlab.com/ee/api/groups.html#create-personal-access-token-for-service-account-user
+type ServiceAccountTokenParameters struct {
+	// GroupID is the ID of the top-level group the service account belongs to.
+	// +optional
+	// +immutable
+	GroupID *int `json:"groupId,omitempty"`
+
+	// GroupIDRef is a reference to a group to retrieve its groupId
+	// +optional
+	// +immutable
+	GroupIDRef *xpv1.Reference `json:"groupIdRef,omitempty"`
+
+	// GroupIDSelector selects reference to a group to retrieve its groupId.
+	// +optional
+	GroupIDSelector *xpv1.Selector `json:"groupIdSelector,omitempty"`
+
+	// GroupPath is the full path of the group, e.g. my-group.
+	// It is resolved to the group ID through the GitLab API when GroupID is
+	// not set, which allows referencing groups not managed by Crossplane.
+	// +optional
+	// +immutable
+	GroupPath *string `json:"groupPath,omitempty"`
+
+	// ServiceAccountID is the user ID of the group service account the token
+	// is created for.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="serviceAccountId is immutable"
+	ServiceAccountID int `json:"serviceAccountId"`
+
+	// Expiration date of the token. If not set, Gitlab applies the maximum
+	// allowable lifetime of a personal access token.
+	// Expected in ISO 8601 format (2019-03-15T08:00:00Z)
+	// +optional
+	// +immutable
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// ExpiresAtPolicy keeps the token valid by rotating it shortly before it
+	// expires. The rotated token is published to the connection secret.
+	// +optional
+	ExpiresAtPolicy *ExpiresAtPolicy `json:"expiresAtPolicy,omitempty"`
+
+	// ExpiryWarningDays is the number of days before the expiration date at
+	// which the TokenExpiring condition becomes true. Defaults to 7.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ExpiryWarningDays *int `json:"expiryWarningDays,omitempty"`
+
+	// Scopes indicates the token scopes, e.g. api or read_repository.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="scopes is immutable"
+	Scopes []string `json:"scopes"`
+
+	// Name of the token.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+}
+
+// ServiceAccountTokenObservation represents a personal access token of a
+// group service account.
+type ServiceAccountTokenObservation struct {
+	TokenID    *int         `json:"id,omitempty"`
+	UserID     *int         `json:"userId,omitempty"`
+	ExpiresAt  *metav1.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *metav1.Time `json:"lastUsedAt,omitempty"`
+	Active     bool         `json:"active,omitempty"`
+	Revoked    bool         `json:"revoked,omitempty"`
+}
+
+// A ServiceAccountTokenSpec defines the desired state of a group service
+// account token.
+type ServiceAccountTokenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceAccountTokenParameters `json:"forProvider"`
+}
+
+// A ServiceAccountTokenStatus represents the observed state of a group
+// service account token.
+type ServiceAccountTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceAccountTokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceAccountToken is a managed resource that represents a personal
+// access token of a Gitlab group service account. The token is written to
+// the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="EXPIRES AT",type="string",JSONPath=".status.atProvider.expiresAt"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ServiceAccountToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceAccountTokenSpec   `json:"spec"`
+	Status ServiceAccountTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountTokenList contains a list of ServiceAccountToken items
+type ServiceAccountTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountToken `json:"items"`
+}
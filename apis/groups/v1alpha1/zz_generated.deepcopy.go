@@ -92,6 +92,14 @@ func (in *AccessTokenObservation) DeepCopyInto(out *AccessTokenObservation) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUsedAt != nil {
+		in, out := &in.LastUsedAt, &out.LastUsedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessTokenObservation.
@@ -122,10 +130,25 @@ func (in *AccessTokenParameters) DeepCopyInto(out *AccessTokenParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GroupPath != nil {
+		in, out := &in.GroupPath, &out.GroupPath
+		*out = new(string)
+		**out = **in
+	}
 	if in.ExpiresAt != nil {
 		in, out := &in.ExpiresAt, &out.ExpiresAt
 		*out = (*in).DeepCopy()
 	}
+	if in.ExpiresAtPolicy != nil {
+		in, out := &in.ExpiresAtPolicy, &out.ExpiresAtPolicy
+		*out = new(ExpiresAtPolicy)
+		**out = **in
+	}
+	if in.ExpiryWarningDays != nil {
+		in, out := &in.ExpiryWarningDays, &out.ExpiryWarningDays
+		*out = new(int)
+		**out = **in
+	}
 	if in.AccessLevel != nil {
 		in, out := &in.AccessLevel, &out.AccessLevel
 		*out = new(AccessLevelValue)
@@ -197,6 +220,41 @@ func (in *CustomAttribute) DeepCopy() *CustomAttribute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultBranchProtectionDefaults) DeepCopyInto(out *DefaultBranchProtectionDefaults) {
+	*out = *in
+	if in.AllowedToPush != nil {
+		in, out := &in.AllowedToPush, &out.AllowedToPush
+		*out = make([]AccessLevelValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowForcePush != nil {
+		in, out := &in.AllowForcePush, &out.AllowForcePush
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowedToMerge != nil {
+		in, out := &in.AllowedToMerge, &out.AllowedToMerge
+		*out = make([]AccessLevelValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeveloperCanInitialPush != nil {
+		in, out := &in.DeveloperCanInitialPush, &out.DeveloperCanInitialPush
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultBranchProtectionDefaults.
+func (in *DefaultBranchProtectionDefaults) DeepCopy() *DefaultBranchProtectionDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultBranchProtectionDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployToken) DeepCopyInto(out *DeployToken) {
 	*out = *in
@@ -289,6 +347,11 @@ func (in *DeployTokenParameters) DeepCopyInto(out *DeployTokenParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GroupPath != nil {
+		in, out := &in.GroupPath, &out.GroupPath
+		*out = new(string)
+		**out = **in
+	}
 	if in.ExpiresAt != nil {
 		in, out := &in.ExpiresAt, &out.ExpiresAt
 		*out = (*in).DeepCopy()
@@ -349,6 +412,209 @@ func (in *DeployTokenStatus) DeepCopy() *DeployTokenStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Epic) DeepCopyInto(out *Epic) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Epic.
+func (in *Epic) DeepCopy() *Epic {
+	if in == nil {
+		return nil
+	}
+	out := new(Epic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Epic) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EpicList) DeepCopyInto(out *EpicList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Epic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EpicList.
+func (in *EpicList) DeepCopy() *EpicList {
+	if in == nil {
+		return nil
+	}
+	out := new(EpicList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EpicList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EpicObservation) DeepCopyInto(out *EpicObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ClosedAt != nil {
+		in, out := &in.ClosedAt, &out.ClosedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EpicObservation.
+func (in *EpicObservation) DeepCopy() *EpicObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EpicObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EpicParameters) DeepCopyInto(out *EpicParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Confidential != nil {
+		in, out := &in.Confidential, &out.Confidential
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StartDate != nil {
+		in, out := &in.StartDate, &out.StartDate
+		*out = (*in).DeepCopy()
+	}
+	if in.DueDate != nil {
+		in, out := &in.DueDate, &out.DueDate
+		*out = (*in).DeepCopy()
+	}
+	if in.ParentID != nil {
+		in, out := &in.ParentID, &out.ParentID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ParentIDRef != nil {
+		in, out := &in.ParentIDRef, &out.ParentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentIDSelector != nil {
+		in, out := &in.ParentIDSelector, &out.ParentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EpicParameters.
+func (in *EpicParameters) DeepCopy() *EpicParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EpicParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EpicSpec) DeepCopyInto(out *EpicSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EpicSpec.
+func (in *EpicSpec) DeepCopy() *EpicSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EpicSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EpicStatus) DeepCopyInto(out *EpicStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EpicStatus.
+func (in *EpicStatus) DeepCopy() *EpicStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EpicStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpiresAtPolicy) DeepCopyInto(out *ExpiresAtPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpiresAtPolicy.
+func (in *ExpiresAtPolicy) DeepCopy() *ExpiresAtPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpiresAtPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -461,6 +727,11 @@ func (in *GroupObservation) DeepCopyInto(out *GroupObservation) {
 		*out = make([]LDAPGroupLink, len(*in))
 		copy(*out, *in)
 	}
+	if in.LDAPSync != nil {
+		in, out := &in.LDAPSync, &out.LDAPSync
+		*out = new(LDAPSyncObservation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MarkedForDeletionOn != nil {
 		in, out := &in.MarkedForDeletionOn, &out.MarkedForDeletionOn
 		*out = (*in).DeepCopy()
@@ -516,6 +787,26 @@ func (in *GroupParameters) DeepCopyInto(out *GroupParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PreventForkingOutsideGroup != nil {
+		in, out := &in.PreventForkingOutsideGroup, &out.PreventForkingOutsideGroup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreventSharingGroupsOutsideHierarchy != nil {
+		in, out := &in.PreventSharingGroupsOutsideHierarchy, &out.PreventSharingGroupsOutsideHierarchy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UniqueProjectDownloadLimit != nil {
+		in, out := &in.UniqueProjectDownloadLimit, &out.UniqueProjectDownloadLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.UniqueProjectDownloadLimitIntervalInSeconds != nil {
+		in, out := &in.UniqueProjectDownloadLimitIntervalInSeconds, &out.UniqueProjectDownloadLimitIntervalInSeconds
+		*out = new(int)
+		**out = **in
+	}
 	if in.RequireTwoFactorAuth != nil {
 		in, out := &in.RequireTwoFactorAuth, &out.RequireTwoFactorAuth
 		*out = new(bool)
@@ -598,6 +889,16 @@ func (in *GroupParameters) DeepCopyInto(out *GroupParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DefaultBranchProtection != nil {
+		in, out := &in.DefaultBranchProtection, &out.DefaultBranchProtection
+		*out = new(int)
+		**out = **in
+	}
+	if in.DefaultBranchProtectionDefaults != nil {
+		in, out := &in.DefaultBranchProtectionDefaults, &out.DefaultBranchProtectionDefaults
+		*out = new(DefaultBranchProtectionDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PermanentlyRemove != nil {
 		in, out := &in.PermanentlyRemove, &out.PermanentlyRemove
 		*out = new(bool)
@@ -608,6 +909,11 @@ func (in *GroupParameters) DeepCopyInto(out *GroupParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RemoveFinalizerOnPendingDeletion != nil {
+		in, out := &in.RemoveFinalizerOnPendingDeletion, &out.RemoveFinalizerOnPendingDeletion
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupParameters.
@@ -669,6 +975,25 @@ func (in *LDAPGroupLink) DeepCopy() *LDAPGroupLink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPSyncObservation) DeepCopyInto(out *LDAPSyncObservation) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSyncObservation.
+func (in *LDAPSyncObservation) DeepCopy() *LDAPSyncObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPSyncObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Member) DeepCopyInto(out *Member) {
 	*out = *in
@@ -766,6 +1091,11 @@ func (in *MemberParameters) DeepCopyInto(out *MemberParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GroupPath != nil {
+		in, out := &in.GroupPath, &out.GroupPath
+		*out = new(string)
+		**out = **in
+	}
 	if in.UserID != nil {
 		in, out := &in.UserID, &out.UserID
 		*out = new(int)
@@ -934,6 +1264,11 @@ func (in *SamlGroupLinkParameters) DeepCopyInto(out *SamlGroupLinkParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GroupPath != nil {
+		in, out := &in.GroupPath, &out.GroupPath
+		*out = new(string)
+		**out = **in
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -990,6 +1325,186 @@ func (in *SamlGroupLinkStatus) DeepCopy() *SamlGroupLinkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountToken) DeepCopyInto(out *ServiceAccountToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountToken.
+func (in *ServiceAccountToken) DeepCopy() *ServiceAccountToken {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenList) DeepCopyInto(out *ServiceAccountTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceAccountToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenList.
+func (in *ServiceAccountTokenList) DeepCopy() *ServiceAccountTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenObservation) DeepCopyInto(out *ServiceAccountTokenObservation) {
+	*out = *in
+	if in.TokenID != nil {
+		in, out := &in.TokenID, &out.TokenID
+		*out = new(int)
+		**out = **in
+	}
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUsedAt != nil {
+		in, out := &in.LastUsedAt, &out.LastUsedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenObservation.
+func (in *ServiceAccountTokenObservation) DeepCopy() *ServiceAccountTokenObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenParameters) DeepCopyInto(out *ServiceAccountTokenParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupPath != nil {
+		in, out := &in.GroupPath, &out.GroupPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAtPolicy != nil {
+		in, out := &in.ExpiresAtPolicy, &out.ExpiresAtPolicy
+		*out = new(ExpiresAtPolicy)
+		**out = **in
+	}
+	if in.ExpiryWarningDays != nil {
+		in, out := &in.ExpiryWarningDays, &out.ExpiryWarningDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenParameters.
+func (in *ServiceAccountTokenParameters) DeepCopy() *ServiceAccountTokenParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenSpec) DeepCopyInto(out *ServiceAccountTokenSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenSpec.
+func (in *ServiceAccountTokenSpec) DeepCopy() *ServiceAccountTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenStatus) DeepCopyInto(out *ServiceAccountTokenStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenStatus.
+func (in *ServiceAccountTokenStatus) DeepCopy() *ServiceAccountTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SharedWithGroups) DeepCopyInto(out *SharedWithGroups) {
 	*out = *in
@@ -1137,6 +1652,21 @@ func (in *VariableList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableObservation) DeepCopyInto(out *VariableObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableObservation.
+func (in *VariableObservation) DeepCopy() *VariableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 	*out = *in
@@ -1155,6 +1685,11 @@ func (in *VariableParameters) DeepCopyInto(out *VariableParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GroupPath != nil {
+		in, out := &in.GroupPath, &out.GroupPath
+		*out = new(string)
+		**out = **in
+	}
 	if in.Value != nil {
 		in, out := &in.Value, &out.Value
 		*out = new(string)
@@ -1223,6 +1758,7 @@ func (in *VariableSpec) DeepCopy() *VariableSpec {
 func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
@@ -1234,3 +1770,146 @@ func (in *VariableStatus) DeepCopy() *VariableStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPage) DeepCopyInto(out *WikiPage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPage.
+func (in *WikiPage) DeepCopy() *WikiPage {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WikiPage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageList) DeepCopyInto(out *WikiPageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WikiPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageList.
+func (in *WikiPageList) DeepCopy() *WikiPageList {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WikiPageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageObservation) DeepCopyInto(out *WikiPageObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageObservation.
+func (in *WikiPageObservation) DeepCopy() *WikiPageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageParameters) DeepCopyInto(out *WikiPageParameters) {
+	*out = *in
+	if in.GroupID != nil {
+		in, out := &in.GroupID, &out.GroupID
+		*out = new(int)
+		**out = **in
+	}
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GroupIDSelector != nil {
+		in, out := &in.GroupIDSelector, &out.GroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(WikiFormatValue)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageParameters.
+func (in *WikiPageParameters) DeepCopy() *WikiPageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageSpec) DeepCopyInto(out *WikiPageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageSpec.
+func (in *WikiPageSpec) DeepCopy() *WikiPageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WikiPageStatus) DeepCopyInto(out *WikiPageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WikiPageStatus.
+func (in *WikiPageStatus) DeepCopy() *WikiPageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WikiPageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
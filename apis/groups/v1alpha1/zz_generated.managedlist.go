@@ -38,6 +38,15 @@ func (l *DeployTokenList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this EpicList.
+func (l *EpicList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this GroupList.
 func (l *GroupList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -65,6 +74,15 @@ func (l *SamlGroupLinkList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ServiceAccountTokenList.
+func (l *ServiceAccountTokenList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this VariableList.
 func (l *VariableList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -73,3 +91,12 @@ func (l *VariableList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this WikiPageList.
+func (l *WikiPageList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
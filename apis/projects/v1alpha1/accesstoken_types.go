@@ -23,6 +23,8 @@ import (
 
 // AccessTokenParameters define the desired state of a Gitlab access token
 // https://docs.gitlab.com/ee/api/access_tokens.html
+// +kubebuilder:validation:XValidation:rule="self.scopes == oldSelf.scopes || (has(self.allowRecreate) && self.allowRecreate)",message="scopes can only be changed when allowRecreate is true"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.accessLevel) || (has(self.accessLevel) && self.accessLevel == oldSelf.accessLevel) || (has(self.allowRecreate) && self.allowRecreate)",message="accessLevel can only be changed when allowRecreate is true"
 type AccessTokenParameters struct {
 	// ProjectID is the ID of the project to create the access token in.
 	// +optional
@@ -39,35 +41,77 @@ type AccessTokenParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the full path of the project, e.g. my-group/my-project.
+	// It is resolved to the project ID through the GitLab API when ProjectID
+	// is not set, which allows referencing projects not managed by Crossplane.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
 	// Expiration date of the access token. The date cannot be set later than the maximum allowable lifetime of an access token.
 	// If not set, the maximum allowable lifetime of a personal access token is 365 days.
 	// Expected in ISO 8601 format (2019-03-15T08:00:00Z)
 	// +immutable
 	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 
+	// ExpiresAtPolicy keeps the access token valid by rotating it shortly
+	// before it expires. The rotated token is published to the connection
+	// secret.
+	// +optional
+	ExpiresAtPolicy *ExpiresAtPolicy `json:"expiresAtPolicy,omitempty"`
+
+	// ExpiryWarningDays is the number of days before the expiration date at
+	// which the TokenExpiring condition becomes true. Defaults to 7.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ExpiryWarningDays *int `json:"expiryWarningDays,omitempty"`
+
 	// Access level for the project. Default is 40.
 	// Valid values are 10 (Guest), 20 (Reporter), 30 (Developer), 40 (Maintainer), and 50 (Owner).
+	// Changing it on an existing token requires AllowRecreate.
 	// +optional
-	// +immutable
 	AccessLevel *AccessLevelValue `json:"accessLevel,omitempty"`
 
 	// Scopes indicates the access token scopes.
 	// Must be at least one of read_repository, read_registry, write_registry,
 	// read_package_registry, or write_package_registry.
-	// +immutable
+	// Changing them on an existing token requires AllowRecreate.
 	Scopes []string `json:"scopes"`
 
+	// AllowRecreate allows the controller to revoke and recreate the access
+	// token when its scopes or access level change, since GitLab does not
+	// support updating them in place. The new token is published to the
+	// connection secret.
+	// +optional
+	AllowRecreate *bool `json:"allowRecreate,omitempty"`
+
 	// Name of the project access token
 	// +required
 	Name string `json:"name"`
 }
 
+// ExpiresAtPolicy defines when and for how long an access token is rotated.
+type ExpiresAtPolicy struct {
+	// RotateDaysBefore is the number of days before the expiration date at
+	// which the access token is rotated.
+	// +kubebuilder:validation:Minimum=1
+	RotateDaysBefore int `json:"rotateDaysBefore"`
+
+	// ValidityDays is the number of days the rotated access token is valid.
+	// +kubebuilder:validation:Minimum=1
+	ValidityDays int `json:"validityDays"`
+}
+
 // AccessTokenObservation represents a access token.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_access_tokens.html
 type AccessTokenObservation struct {
-	TokenID *int `json:"id,omitempty"`
+	TokenID    *int         `json:"id,omitempty"`
+	ExpiresAt  *metav1.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *metav1.Time `json:"lastUsedAt,omitempty"`
+	Active     bool         `json:"active,omitempty"`
+	Revoked    bool         `json:"revoked,omitempty"`
 }
 
 // A AccessTokenSpec defines the desired state of a Gitlab Project.
@@ -88,6 +132,8 @@ type AccessTokenStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="EXPIRES AT",type="string",JSONPath=".spec.forProvider.expiresAt"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type AccessToken struct {
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CommitActionValue represents the operation a commit action performs on a
+// file.
+type CommitActionValue string
+
+// List of available commit actions.
+const (
+	CommitActionCreate CommitActionValue = "create"
+	CommitActionDelete CommitActionValue = "delete"
+	CommitActionMove   CommitActionValue = "move"
+	CommitActionUpdate CommitActionValue = "update"
+	CommitActionChmod  CommitActionValue = "chmod"
+)
+
+// CommitAction describes a single file change of a commit.
+type CommitAction struct {
+	// Action to perform on the file.
+	// +kubebuilder:validation:Enum:=create;delete;move;update;chmod
+	Action CommitActionValue `json:"action"`
+
+	// FilePath is the full path to the file.
+	FilePath string `json:"filePath"`
+
+	// PreviousPath is the original full path to the file being moved.
+	// Only considered for move actions.
+	// +optional
+	PreviousPath *string `json:"previousPath,omitempty"`
+
+	// Content of the file. Required for create actions.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// Encoding of the content, either text or base64.
+	// +optional
+	// +kubebuilder:validation:Enum:=text;base64
+	Encoding *string `json:"encoding,omitempty"`
+
+	// LastCommitID is the last known commit ID of the file. The commit is
+	// rejected if the file changed since.
+	// +optional
+	LastCommitID *string `json:"lastCommitId,omitempty"`
+
+	// ExecuteFilemode sets or unsets the execute flag of the file. Only
+	// considered for chmod actions.
+	// +optional
+	ExecuteFilemode *bool `json:"executeFilemode,omitempty"`
+}
+
+// CommitParameters define the desired state of a Gitlab repository commit.
+// A commit is created once and cannot be changed afterwards.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type CommitParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Branch to commit to. The branch is created from StartBranch or
+	// StartSHA if it does not exist yet.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="branch is immutable"
+	Branch string `json:"branch"`
+
+	// CommitMessage of the commit.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="commitMessage is immutable"
+	CommitMessage string `json:"commitMessage"`
+
+	// StartBranch is the name of the branch to start the new branch from.
+	// +optional
+	// +immutable
+	StartBranch *string `json:"startBranch,omitempty"`
+
+	// StartSHA is the SHA of the commit to start the new branch from.
+	// +optional
+	// +immutable
+	StartSHA *string `json:"startSha,omitempty"`
+
+	// Actions to perform on the files of the repository.
+	// +immutable
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="actions are immutable"
+	Actions []CommitAction `json:"actions"`
+
+	// AuthorEmail specifies the commit author's email address.
+	// +optional
+	// +immutable
+	AuthorEmail *string `json:"authorEmail,omitempty"`
+
+	// AuthorName specifies the commit author's name.
+	// +optional
+	// +immutable
+	AuthorName *string `json:"authorName,omitempty"`
+
+	// Force overwrites the target branch with a new commit based on
+	// StartBranch or StartSHA.
+	// +optional
+	// +immutable
+	Force *bool `json:"force,omitempty"`
+}
+
+// CommitObservation represents the observed state of a Gitlab repository
+// commit.
+type CommitObservation struct {
+	ID            string       `json:"id,omitempty"`
+	ShortID       string       `json:"shortId,omitempty"`
+	Title         string       `json:"title,omitempty"`
+	AuthorName    string       `json:"authorName,omitempty"`
+	AuthorEmail   string       `json:"authorEmail,omitempty"`
+	CommittedDate *metav1.Time `json:"committedDate,omitempty"`
+	WebURL        string       `json:"webUrl,omitempty"`
+}
+
+// A CommitSpec defines the desired state of a Commit.
+type CommitSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CommitParameters `json:"forProvider"`
+}
+
+// A CommitStatus represents the observed state of a Commit.
+type CommitStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CommitObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Commit is a managed resource that represents a commit pushed to a Gitlab
+// repository. Commits cannot be removed from the repository history, so
+// deleting a Commit only stops managing it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".spec.forProvider.branch"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Commit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CommitSpec   `json:"spec"`
+	Status CommitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CommitList contains a list of Commit items
+type CommitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Commit `json:"items"`
+}
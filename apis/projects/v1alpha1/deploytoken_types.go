@@ -38,6 +38,13 @@ type DeployTokenParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the full path of the project, e.g. my-group/my-project.
+	// It is resolved to the project ID through the GitLab API when ProjectID
+	// is not set, which allows referencing projects not managed by Crossplane.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
 	// Expiration date for the deploy token. Does not expire if no value is provided.
 	// Expected in ISO 8601 format (2019-03-15T08:00:00Z)
 	// +optional
@@ -53,6 +60,7 @@ type DeployTokenParameters struct {
 	// Must be at least one of read_repository, read_registry, write_registry,
 	// read_package_registry, or write_package_registry.
 	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="scopes is immutable"
 	Scopes []string `json:"scopes"`
 }
 
@@ -80,6 +88,8 @@ type DeployTokenStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:printcolumn:name="EXPIRES AT",type="string",JSONPath=".spec.forProvider.expiresAt"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type DeployToken struct {
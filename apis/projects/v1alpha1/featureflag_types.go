@@ -0,0 +1,192 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FeatureFlagStrategyName is the name of a feature flag strategy.
+type FeatureFlagStrategyName string
+
+// List of available feature flag strategies.
+const (
+	FeatureFlagStrategyDefault         FeatureFlagStrategyName = "default"
+	FeatureFlagStrategyGradualRollout  FeatureFlagStrategyName = "gradualRolloutUserId"
+	FeatureFlagStrategyUserWithID      FeatureFlagStrategyName = "userWithId"
+	FeatureFlagStrategyGitlabUserList  FeatureFlagStrategyName = "gitlabUserList"
+	FeatureFlagStrategyFlexibleRollout FeatureFlagStrategyName = "flexibleRollout"
+)
+
+// FeatureFlagStrategyParameters configures a feature flag strategy. Which
+// parameters apply depends on the strategy.
+type FeatureFlagStrategyParameters struct {
+	// GroupID groups the users a gradual rollout applies to.
+	// +optional
+	GroupID *string `json:"groupId,omitempty"`
+
+	// UserIDs is a comma-separated list of the user IDs the flag is
+	// enabled for.
+	// +optional
+	UserIDs *string `json:"userIds,omitempty"`
+
+	// Percentage of users the flag is enabled for in a gradual rollout.
+	// +optional
+	Percentage *string `json:"percentage,omitempty"`
+
+	// Rollout percentage of a flexible rollout.
+	// +optional
+	Rollout *string `json:"rollout,omitempty"`
+
+	// Stickiness of a flexible rollout, e.g. default, userId, sessionId or
+	// random.
+	// +optional
+	Stickiness *string `json:"stickiness,omitempty"`
+}
+
+// FeatureFlagScope limits a feature flag strategy to an environment.
+type FeatureFlagScope struct {
+	// EnvironmentScope is the environment the strategy applies to. Wildcards
+	// are supported, e.g. review/*. Use * for all environments.
+	EnvironmentScope string `json:"environmentScope"`
+}
+
+// FeatureFlagStrategy decides for whom a feature flag is enabled.
+type FeatureFlagStrategy struct {
+	// Name of the strategy.
+	// +kubebuilder:validation:Enum:=default;gradualRolloutUserId;userWithId;gitlabUserList;flexibleRollout
+	Name FeatureFlagStrategyName `json:"name"`
+
+	// Parameters of the strategy.
+	// +optional
+	Parameters *FeatureFlagStrategyParameters `json:"parameters,omitempty"`
+
+	// Scopes are the environments the strategy applies to.
+	// +optional
+	Scopes []FeatureFlagScope `json:"scopes,omitempty"`
+
+	// UserListID is the ID of the user list a gitlabUserList strategy
+	// enables the flag for.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.FeatureFlagUserList
+	// +crossplane:generate:reference:extractor=FeatureFlagUserListID()
+	// +crossplane:generate:reference:refFieldName=UserListIDRef
+	// +crossplane:generate:reference:selectorFieldName=UserListIDSelector
+	UserListID *int64 `json:"userListId,omitempty"`
+
+	// UserListIDRef is a reference to a feature flag user list to retrieve
+	// its UserListID.
+	// +optional
+	UserListIDRef *xpv1.Reference `json:"userListIdRef,omitempty"`
+
+	// UserListIDSelector selects reference to a feature flag user list to
+	// retrieve its UserListID.
+	// +optional
+	UserListIDSelector *xpv1.Selector `json:"userListIdSelector,omitempty"`
+}
+
+// FeatureFlagParameters define the desired state of a Gitlab project feature
+// flag.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flags.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type FeatureFlagParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the feature flag.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	Name string `json:"name"`
+
+	// Description of the feature flag.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Active enables or disables the feature flag.
+	// +optional
+	Active *bool `json:"active,omitempty"`
+
+	// Strategies of the feature flag. Strategies and their scopes are
+	// matched to the existing ones by their position in the list.
+	// +optional
+	Strategies []FeatureFlagStrategy `json:"strategies,omitempty"`
+}
+
+// FeatureFlagObservation represents the observed state of a Gitlab project
+// feature flag.
+type FeatureFlagObservation struct {
+	Version   string       `json:"version,omitempty"`
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A FeatureFlagSpec defines the desired state of a FeatureFlag.
+type FeatureFlagSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FeatureFlagParameters `json:"forProvider"`
+}
+
+// A FeatureFlagStatus represents the observed state of a FeatureFlag.
+type FeatureFlagStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FeatureFlagObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FeatureFlag is a managed resource that represents a Gitlab project
+// feature flag.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="ACTIVE",type="boolean",JSONPath=".spec.forProvider.active"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type FeatureFlag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeatureFlagSpec   `json:"spec"`
+	Status FeatureFlagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeatureFlagList contains a list of FeatureFlag items
+type FeatureFlagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FeatureFlag `json:"items"`
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FeatureFlagUserListParameters define the desired state of a Gitlab project
+// feature flag user list.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flag_user_lists.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type FeatureFlagUserListParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Name of the user list.
+	Name string `json:"name"`
+
+	// UserXIDs are the external user IDs of the users in the list.
+	// +kubebuilder:validation:MinItems=1
+	UserXIDs []string `json:"userXids"`
+}
+
+// FeatureFlagUserListObservation represents the observed state of a Gitlab
+// project feature flag user list.
+type FeatureFlagUserListObservation struct {
+	// ID of the user list. Feature flag strategies refer to the list by this
+	// ID rather than by its IID.
+	ID        int          `json:"id,omitempty"`
+	IID       int          `json:"iid,omitempty"`
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// A FeatureFlagUserListSpec defines the desired state of a
+// FeatureFlagUserList.
+type FeatureFlagUserListSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FeatureFlagUserListParameters `json:"forProvider"`
+}
+
+// A FeatureFlagUserListStatus represents the observed state of a
+// FeatureFlagUserList.
+type FeatureFlagUserListStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FeatureFlagUserListObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FeatureFlagUserList is a managed resource that represents a Gitlab
+// project feature flag user list.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type FeatureFlagUserList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeatureFlagUserListSpec   `json:"spec"`
+	Status FeatureFlagUserListStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeatureFlagUserListList contains a list of FeatureFlagUserList items
+type FeatureFlagUserListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FeatureFlagUserList `json:"items"`
+}
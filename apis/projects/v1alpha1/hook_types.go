@@ -44,6 +44,13 @@ type HookParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the full path of the project, e.g. my-group/my-project.
+	// It is resolved to the project ID through the GitLab API when ProjectID
+	// is not set, which allows referencing projects not managed by Crossplane.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
 	// PushEvents triggers hook on push events.
 	// +optional
 	PushEvents *bool `json:"pushEvents,omitempty"`
@@ -126,6 +133,7 @@ type HookStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="PROJECT ID",type="integer",JSONPath=".spec.forProvider.projectId"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type Hook struct {
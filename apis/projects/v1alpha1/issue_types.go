@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IssueParameters define the desired state of a Gitlab Project Issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/issues.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type IssueParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Title of the issue.
+	// +required
+	Title string `json:"title"`
+
+	// Description of the issue. Limited to 1,048,576 characters.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels of the issue.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// AssigneeIDs are the IDs of the users to assign the issue to.
+	// +optional
+	AssigneeIDs []int `json:"assigneeIds,omitempty"`
+
+	// MilestoneID is the global ID of a milestone to assign the issue to.
+	// +optional
+	MilestoneID *int `json:"milestoneId,omitempty"`
+
+	// Confidential sets whether the issue should be confidential.
+	// +optional
+	Confidential *bool `json:"confidential,omitempty"`
+}
+
+// IssueObservation represents the observed state of a Gitlab Project Issue.
+type IssueObservation struct {
+	ID        int          `json:"id,omitempty"`
+	IID       int          `json:"iid,omitempty"`
+	State     string       `json:"state,omitempty"`
+	WebURL    string       `json:"webUrl,omitempty"`
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+	ClosedAt  *metav1.Time `json:"closedAt,omitempty"`
+}
+
+// An IssueSpec defines the desired state of a Gitlab Project Issue.
+type IssueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IssueParameters `json:"forProvider"`
+}
+
+// An IssueStatus represents the observed state of a Gitlab Project Issue.
+type IssueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IssueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Issue is a managed resource that represents a Gitlab Project Issue.
+// Its external name is the IID of the issue within the project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="IID",type="integer",JSONPath=".status.atProvider.iid"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.title"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type Issue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IssueSpec   `json:"spec"`
+	Status IssueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IssueList contains a list of Issue items
+type IssueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Issue `json:"items"`
+}
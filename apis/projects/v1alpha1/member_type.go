@@ -38,6 +38,13 @@ type MemberParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the full path of the project, e.g. my-group/my-project.
+	// It is resolved to the project ID through the GitLab API when ProjectID
+	// is not set, which allows referencing projects not managed by Crossplane.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
 	// The user ID of the member.
 	// +optional
 	UserID *int `json:"userID,omitempty"`
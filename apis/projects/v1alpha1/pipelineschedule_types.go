@@ -45,6 +45,13 @@ type PipelineScheduleParameters struct {
 	// +immutable
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the full path of the project, e.g. my-group/my-project.
+	// It is resolved to the project ID through the GitLab API when ProjectID
+	// is not set, which allows referencing projects not managed by Crossplane.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
 	// Description is a description of the pipeline schedule.
 	// +required
 	Description string `json:"description"`
@@ -120,6 +127,8 @@ type PipelineScheduleStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="REF",type="string",JSONPath=".spec.forProvider.ref"
+// +kubebuilder:printcolumn:name="CRON",type="string",JSONPath=".spec.forProvider.cron"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type PipelineSchedule struct {
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -40,6 +41,7 @@ const (
 // VisibilityValue represents a visibility level within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/
+// +kubebuilder:validation:Enum=private;internal;public
 type VisibilityValue string
 
 // List of available visibility levels.
@@ -65,6 +67,21 @@ const (
 	RebaseMerge        MergeMethodValue = "rebase_merge"
 )
 
+// SquashOptionValue represents a squash option within GitLab.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#create-project
+type SquashOptionValue string
+
+// List of available squash options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/projects.html#create-project
+const (
+	SquashOptionNever      SquashOptionValue = "never"
+	SquashOptionAlways     SquashOptionValue = "always"
+	SquashOptionDefaultOff SquashOptionValue = "default_off"
+	SquashOptionDefaultOn  SquashOptionValue = "default_on"
+)
+
 // UserIdentity represents a user identity.
 type UserIdentity struct {
 	Provider  string `json:"provider"`
@@ -122,6 +139,23 @@ type ContainerExpirationPolicy struct {
 	NextRunAt       *metav1.Time `json:"nextRunAt"`
 }
 
+// NamespaceSelector selects a Group to retrieve the namespace of a project.
+// In addition to matching labels and controller reference, the selection can
+// be restricted to subgroups of a given parent group.
+type NamespaceSelector struct {
+	xpv1.Selector `json:",inline"`
+
+	// MatchParentID restricts the selection to groups whose parent group has
+	// the given ID.
+	// +optional
+	MatchParentID *int `json:"matchParentId,omitempty"`
+
+	// MatchParentRef restricts the selection to groups whose parent group is
+	// the referenced Group.
+	// +optional
+	MatchParentRef *xpv1.Reference `json:"matchParentRef,omitempty"`
+}
+
 // ProjectLicense represent the license for a project.
 type ProjectLicense struct {
 	Key       string `json:"key"`
@@ -144,10 +178,12 @@ type ContainerExpirationPolicyAttributes struct {
 	Enabled         *bool   `json:"enabled,omitempty"`
 
 	// Deprecated members
-	NameRegex *string `url:"name_regex,omitempty" json:"name_regex,omitempty"`
+	NameRegex *string `url:"name_regex,omitempty" json:"name_regex,omitempty" gitlab:"-"`
 }
 
 // ProjectParameters define the desired state of a Gitlab Project
+// +kubebuilder:validation:XValidation:rule="!has(self.useCustomTemplate) || !self.useCustomTemplate || has(self.templateName)",message="templateName is required when useCustomTemplate is true"
+// +kubebuilder:validation:XValidation:rule="!has(self.groupWithProjectTemplatesId) || (has(self.useCustomTemplate) && self.useCustomTemplate)",message="groupWithProjectTemplatesId requires useCustomTemplate to be true"
 type ProjectParameters struct {
 	// Set whether or not merge requests can be merged with skipped jobs.
 	// +optional
@@ -205,13 +241,15 @@ type ProjectParameters struct {
 	// Update the image cleanup policy for this project. Accepts: cadence (string), keepN (integer), olderThan (string),
 	// nameRegex (string), nameRegexDelete (string), nameRegexKeep (string), enabled (boolean).
 	// +optional
-	ContainerExpirationPolicyAttributes *ContainerExpirationPolicyAttributes `json:"containerExpirationPolicyAttributes,omitempty"`
+	ContainerExpirationPolicyAttributes *ContainerExpirationPolicyAttributes `json:"containerExpirationPolicyAttributes,omitempty" gitlab:"ContainerExpirationPolicy"`
 
 	// Enable container registry for this project.
 	// +optional
 	ContainerRegistryEnabled *bool `json:"containerRegistryEnabled,omitempty"`
 
-	// The default branch name. Requires initializeWithReadme to be true.
+	// The default branch name. If the repository is empty, the branch is
+	// created by an initial commit adding a README.md, unless the project is
+	// imported or mirrored.
 	// +optional
 	DefaultBranch *string `json:"defaultBranch,omitempty"`
 
@@ -245,7 +283,7 @@ type ProjectParameters struct {
 
 	// URL to import repository from.
 	// +optional
-	ImportURL *string `json:"importUrl,omitempty"`
+	ImportURL *string `json:"importUrl,omitempty" gitlab:"-"`
 
 	// false by default.
 	// +optional
@@ -265,10 +303,19 @@ type ProjectParameters struct {
 	// +optional
 	LFSEnabled *bool `json:"lfsEnabled,omitempty"`
 
+	// Template used to create merge commit message in merge requests.
+	// +kubebuilder:validation:MaxLength:=500
+	// +optional
+	MergeCommitTemplate *string `json:"mergeCommitTemplate,omitempty"`
+
 	// Set the merge method used.
 	// +optional
 	MergeMethod *MergeMethodValue `json:"mergeMethod,omitempty"`
 
+	// Enable merged results pipelines. Premium and Ultimate only.
+	// +optional
+	MergePipelinesEnabled *bool `json:"mergePipelinesEnabled,omitempty"`
+
 	// One of disabled, private, or enabled.
 	// +optional
 	MergeRequestsAccessLevel *AccessControlValue `json:"mergeRequestsAccessLevel,omitempty"`
@@ -278,6 +325,10 @@ type ProjectParameters struct {
 	// +optional
 	MergeRequestsTemplate *string `json:"mergeRequestsTemplate,omitempty"`
 
+	// Enable merge trains. Requires mergePipelinesEnabled. Premium and Ultimate only.
+	// +optional
+	MergeTrainsEnabled *bool `json:"mergeTrainsEnabled,omitempty"`
+
 	// Enables pull mirroring in a project.
 	// +optional
 	Mirror *bool `json:"mirror,omitempty"`
@@ -295,7 +346,11 @@ type ProjectParameters struct {
 	MirrorUserID *int `json:"mirrorUserId,omitempty"`
 
 	// Namespace for the new project (defaults to the current user’s namespace).
+	// Transferring projects between namespaces is not supported, so it can't
+	// be changed once set.
 	// +optional
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="namespaceId is immutable"
 	NamespaceID *int `json:"namespaceId,omitempty"`
 
 	// NamespaceIDRef is a reference to a project to retrieve its namespaceId
@@ -303,9 +358,9 @@ type ProjectParameters struct {
 	// +immutable
 	NamespaceIDRef *xpv1.Reference `json:"namespaceIdRef,omitempty"`
 
-	// NamespaceIDSelector selects reference to a project to retrieve its namespaceId.
+	// NamespaceIDSelector selects reference to a group to retrieve its namespaceId.
 	// +optional
-	NamespaceIDSelector *xpv1.Selector `json:"namespaceIdSelector,omitempty"`
+	NamespaceIDSelector *NamespaceSelector `json:"namespaceIdSelector,omitempty"`
 
 	// Set whether merge requests can only be merged when all the discussions are resolved.
 	// +optional
@@ -331,11 +386,26 @@ type ProjectParameters struct {
 	// +optional
 	PagesAccessLevel *AccessControlValue `json:"pagesAccessLevel,omitempty"`
 
+	// Force HTTPS for the Pages site of the project. Requires a valid
+	// certificate for custom domains.
+	// +optional
+	PagesHTTPSOnly *bool `json:"pagesHttpsOnly,omitempty"`
+
+	// Serve the Pages site of the project from a unique domain instead of
+	// the namespace domain.
+	// +optional
+	PagesUniqueDomainEnabled *bool `json:"pagesUniqueDomainEnabled,omitempty"`
+
 	// Repository name for new project.
 	// Generated based on name if not provided (generated as lowercase with dashes).
 	// +optional
 	Path *string `json:"path,omitempty"`
 
+	// Enable pre-receive secret detection. Only applied when set.
+	// Superseded by secretPushProtectionEnabled in GitLab 17.3. Ultimate only.
+	// +optional
+	PreReceiveSecretDetectionEnabled *bool `json:"preReceiveSecretDetectionEnabled,omitempty"`
+
 	// Show link to create/view merge request when pushing from the command line.
 	// +optional
 	// +immutable
@@ -343,7 +413,14 @@ type ProjectParameters struct {
 
 	// If true, jobs can be viewed by non-project members.
 	// +optional
-	PublicBuilds *bool `json:"publicBuilds,omitempty"`
+	PublicBuilds *bool `json:"publicBuilds,omitempty" gitlab:"PublicJobs"`
+
+	// RemoveFinalizerOnPendingDeletion removes the finalizer of a deleted
+	// Project as soon as GitLab has marked the project for deletion. By
+	// default the finalizer is kept until GitLab has carried out a delayed
+	// deletion, which on Premium and Ultimate takes several days.
+	// +optional
+	RemoveFinalizerOnPendingDeletion *bool `json:"removeFinalizerOnPendingDeletion,omitempty" gitlab:"-"`
 
 	// Enable Delete source branch option by default for all new merge requests.
 	// +optional
@@ -353,6 +430,13 @@ type ProjectParameters struct {
 	// +optional
 	RepositoryAccessLevel *AccessControlValue `json:"repositoryAccessLevel,omitempty"`
 
+	// RepositorySizeThreshold is the repository size, e.g. 5Gi, above which
+	// a StorageThresholdExceeded condition and a warning event are reported.
+	// Statistics are fetched on every poll if no statisticsRefreshInterval
+	// is set.
+	// +optional
+	RepositorySizeThreshold *resource.Quantity `json:"repositorySizeThreshold,omitempty"`
+
 	// Allow users to request member access.
 	// +optional
 	RequestAccessEnabled *bool `json:"requestAccessEnabled,omitempty"`
@@ -361,6 +445,11 @@ type ProjectParameters struct {
 	// +optional
 	ResolveOutdatedDiffDiscussions *bool `json:"resolveOutdatedDiffDiscussions,omitempty"`
 
+	// Enable secret push protection, which blocks pushes containing secrets.
+	// Only applied when set. Requires GitLab 17.3 or later and Ultimate.
+	// +optional
+	SecretPushProtectionEnabled *bool `json:"secretPushProtectionEnabled,omitempty"`
+
 	// Enable or disable Service Desk feature.
 	// +optional
 	ServiceDeskEnabled *bool `json:"serviceDeskEnabled,omitempty"`
@@ -373,6 +462,22 @@ type ProjectParameters struct {
 	// +optional
 	SnippetsAccessLevel *AccessControlValue `json:"snippetsAccessLevel,omitempty"`
 
+	// Template used to create squash commit message in merge requests.
+	// +kubebuilder:validation:MaxLength:=500
+	// +optional
+	SquashCommitTemplate *string `json:"squashCommitTemplate,omitempty"`
+
+	// One of never, always, default_on, or default_off.
+	// +kubebuilder:validation:Enum:=never;always;default_on;default_off
+	// +optional
+	SquashOption *SquashOptionValue `json:"squashOption,omitempty"`
+
+	// StatisticsRefreshInterval is how often the project statistics are
+	// refreshed into status.atProvider.statistics, e.g. 1h. Statistics are
+	// not fetched if neither this nor repositorySizeThreshold is set.
+	// +optional
+	StatisticsRefreshInterval *metav1.Duration `json:"statisticsRefreshInterval,omitempty"`
+
 	// The commit message used to apply merge request suggestions.
 	// +optional
 	SuggestionCommitMessage *string `json:"suggestionCommitMessage,omitempty"`
@@ -428,6 +533,7 @@ type Permissions struct {
 // AccessLevelValue represents a permission level within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/permissions/permissions.html
+// +kubebuilder:validation:Enum=0;5;10;20;30;40;50
 type AccessLevelValue int
 
 // NotificationLevelValue represents a notification level.
@@ -497,6 +603,24 @@ type SharedWithGroups struct {
 	GroupAccessLevel int    `json:"groupAccessLevel,omitempty"`
 }
 
+// AnnotationKeyTriggerHousekeeping can be set on a Project to start the
+// housekeeping task of its repository, e.g. on instances where automatic
+// housekeeping is disabled. Housekeeping is started whenever the value of the
+// annotation changes, e.g. to the current timestamp.
+const AnnotationKeyTriggerHousekeeping = "gitlab.crossplane.io/trigger-housekeeping"
+
+// HousekeepingObservation represents the last housekeeping task started
+// through the AnnotationKeyTriggerHousekeeping annotation.
+type HousekeepingObservation struct {
+	// Trigger is the value of the annotation that requested the last
+	// housekeeping task.
+	Trigger string `json:"trigger,omitempty"`
+
+	// LastStartTime is the time the last housekeeping task was requested.
+	// GitLab runs the task asynchronously.
+	LastStartTime *metav1.Time `json:"lastStartTime,omitempty"`
+}
+
 // ProjectObservation is the observed state of a Project.
 type ProjectObservation struct {
 	ID                        int                        `json:"id,omitempty"`
@@ -511,32 +635,38 @@ type ProjectObservation struct {
 	ForkedFromProject         *ForkParent                `json:"forkedFromProject,omitempty"`
 	ForksCount                int                        `json:"forksCount,omitempty"`
 	HTTPURLToRepo             string                     `json:"httpUrlToRepo,omitempty"`
+	Housekeeping              *HousekeepingObservation   `json:"housekeeping,omitempty"`
 	ImportError               string                     `json:"importError,omitempty"`
 	ImportStatus              string                     `json:"importStatus,omitempty"`
-	IssuesEnabled             bool                       `json:"issuesEnabled,omitempty"`
-	JobsEnabled               bool                       `json:"jobsEnabled,omitempty"`
-	LastActivityAt            *metav1.Time               `json:"lastActivityAt,omitempty"`
-	License                   *ProjectLicense            `json:"license,omitempty"`
-	LicenseURL                string                     `json:"licenseUrl,omitempty"`
-	Links                     *Links                     `json:"links,omitempty"`
-	MarkedForDeletionAt       *metav1.Time               `json:"markedForDeletionAt,omitempty"`
-	MergeRequestsEnabled      bool                       `json:"mergeRequestsEnabled,omitempty"`
-	NameWithNamespace         string                     `json:"nameWithNamespace,omitempty"`
-	Namespace                 *ProjectNamespace          `json:"namespace,omitempty"`
-	OpenIssuesCount           int                        `json:"openIssuesCount,omitempty"`
-	Owner                     *User                      `json:"owner,omitempty"`
-	PathWithNamespace         string                     `json:"pathWithNamespace,omitempty"`
-	Permissions               *Permissions               `json:"permissions,omitempty"`
-	Public                    bool                       `json:"public,omitempty"`
-	ReadmeURL                 string                     `json:"readmeUrl,omitempty"`
-	SSHURLToRepo              string                     `json:"sshUrlToRepo,omitempty"`
-	ServiceDeskAddress        string                     `json:"serviceDeskAddress,omitempty"`
-	SharedWithGroups          []SharedWithGroups         `json:"sharedWithGroups,omitempty"`
-	SnippetsEnabled           bool                       `json:"snippetsEnabled,omitempty"`
-	StarCount                 int                        `json:"starCount,omitempty"`
-	Statistics                *ProjectStatistics         `json:"statistics,omitempty"`
-	WebURL                    string                     `json:"webUrl,omitempty"`
-	WikiEnabled               bool                       `json:"wikiEnabled,omitempty"`
+	// ImportURL is the URL the repository was imported from, without the
+	// credentials it may have been given with.
+	ImportURL             string             `json:"importUrl,omitempty"`
+	IssuesEnabled         bool               `json:"issuesEnabled,omitempty"`
+	JobsEnabled           bool               `json:"jobsEnabled,omitempty"`
+	LastActivityAt        *metav1.Time       `json:"lastActivityAt,omitempty"`
+	License               *ProjectLicense    `json:"license,omitempty"`
+	LicenseURL            string             `json:"licenseUrl,omitempty"`
+	Links                 *Links             `json:"links,omitempty"`
+	MarkedForDeletionAt   *metav1.Time       `json:"markedForDeletionAt,omitempty"`
+	MergeRequestsEnabled  bool               `json:"mergeRequestsEnabled,omitempty"`
+	NameWithNamespace     string             `json:"nameWithNamespace,omitempty"`
+	Namespace             *ProjectNamespace  `json:"namespace,omitempty"`
+	OpenIssuesCount       int                `json:"openIssuesCount,omitempty"`
+	PagesURL              string             `json:"pagesUrl,omitempty"`
+	Owner                 *User              `json:"owner,omitempty"`
+	PathWithNamespace     string             `json:"pathWithNamespace,omitempty"`
+	Permissions           *Permissions       `json:"permissions,omitempty"`
+	Public                bool               `json:"public,omitempty"`
+	ReadmeURL             string             `json:"readmeUrl,omitempty"`
+	SSHURLToRepo          string             `json:"sshUrlToRepo,omitempty"`
+	ServiceDeskAddress    string             `json:"serviceDeskAddress,omitempty"`
+	SharedWithGroups      []SharedWithGroups `json:"sharedWithGroups,omitempty"`
+	SnippetsEnabled       bool               `json:"snippetsEnabled,omitempty"`
+	StarCount             int                `json:"starCount,omitempty"`
+	Statistics            *ProjectStatistics `json:"statistics,omitempty"`
+	StatisticsRefreshedAt *metav1.Time       `json:"statisticsRefreshedAt,omitempty"`
+	WebURL                string             `json:"webUrl,omitempty"`
+	WikiEnabled           bool               `json:"wikiEnabled,omitempty"`
 }
 
 // A ProjectSpec defines the desired state of a Gitlab Project.
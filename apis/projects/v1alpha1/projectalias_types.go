@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectAliasParameters define the desired state of a Gitlab project alias.
+// Project aliases are only available on self-managed GitLab Premium and
+// require administrator access.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_aliases.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type ProjectAliasParameters struct {
+	// The ID or URL-encoded path of the project the alias points to.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Alias is the name of the alias, which can be used in clone URLs
+	// instead of the full path of the project, e.g.
+	// git clone git@gitlab.example.com:<alias>.git
+	// +required
+	// +immutable
+	// +kubebuilder:validation:MinLength=1
+	Alias string `json:"alias"`
+}
+
+// ProjectAliasObservation represents the observed state of a Gitlab project
+// alias.
+type ProjectAliasObservation struct {
+	ID        int `json:"id,omitempty"`
+	ProjectID int `json:"projectId,omitempty"`
+}
+
+// A ProjectAliasSpec defines the desired state of a ProjectAlias.
+type ProjectAliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectAliasParameters `json:"forProvider"`
+}
+
+// A ProjectAliasStatus represents the observed state of a ProjectAlias.
+type ProjectAliasStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectAliasObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectAlias is a managed resource that represents a Gitlab project alias.
+// Its external name is the name of the alias.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".spec.forProvider.alias"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type ProjectAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectAliasSpec   `json:"spec"`
+	Status ProjectAliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectAliasList contains a list of ProjectAlias items
+type ProjectAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectAlias `json:"items"`
+}
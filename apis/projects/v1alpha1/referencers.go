@@ -20,13 +20,24 @@ import (
 	"context"
 	"strconv"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 )
 
+const (
+	errGetParentGroup      = "cannot get parent group"
+	errParentGroupNotReady = "parent group has no external name yet"
+	errListGroups          = "cannot list groups"
+	errNoGroupMatches      = "no group matches the selector and parent group"
+)
+
 // resolve int ptr to string value
 func fromPtrValue(v *int) string {
 	if v == nil {
@@ -49,6 +60,19 @@ func toPtrValue(v string) *int {
 	return &r
 }
 
+// FeatureFlagUserListID extracts the ID of a FeatureFlagUserList. Feature
+// flag strategies refer to user lists by ID, while the external name of a
+// user list is its IID.
+func FeatureFlagUserListID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		ul, ok := mg.(*FeatureFlagUserList)
+		if !ok || ul.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return strconv.Itoa(ul.Status.AtProvider.ID)
+	}
+}
+
 // ResolveReferences of this Hook
 func (mg *Hook) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -77,11 +101,25 @@ func (mg *Hook) ResolveReferences(ctx context.Context, c client.Reader) error {
 func (mg *Project) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
 
+	// select a group matching the parent group of spec.forProvider.namespaceIdSelector
+	if mg.Spec.ForProvider.NamespaceID == nil && mg.Spec.ForProvider.NamespaceIDRef == nil {
+		ref, err := selectNamespace(ctx, c, mg, mg.Spec.ForProvider.NamespaceIDSelector)
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.namespaceId")
+		}
+		mg.Spec.ForProvider.NamespaceIDRef = ref
+	}
+
+	var selector *xpv1.Selector
+	if mg.Spec.ForProvider.NamespaceIDSelector != nil {
+		selector = &mg.Spec.ForProvider.NamespaceIDSelector.Selector
+	}
+
 	// resolve spec.forProvider.namespaceIdRef
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: fromPtrValue(mg.Spec.ForProvider.NamespaceID),
 		Reference:    mg.Spec.ForProvider.NamespaceIDRef,
-		Selector:     mg.Spec.ForProvider.NamespaceIDSelector,
+		Selector:     selector,
 		To:           reference.To{Managed: &v1alpha1.Group{}, List: &v1alpha1.GroupList{}},
 		Extract:      reference.ExternalName(),
 	})
@@ -164,3 +202,45 @@ func (mg *Variable) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 	return nil
 }
+
+// selectNamespace selects a Group for the namespace of a project when the
+// selector restricts the selection to subgroups of a parent group. The plain
+// label and controller reference matching is left to the reference resolver,
+// so nil is returned if the selector does not match on the parent group.
+func selectNamespace(ctx context.Context, c client.Reader, from resource.Managed, sel *NamespaceSelector) (*xpv1.Reference, error) {
+	if sel == nil || (sel.MatchParentID == nil && sel.MatchParentRef == nil) {
+		return nil, nil
+	}
+
+	parentID := sel.MatchParentID
+	if sel.MatchParentRef != nil {
+		parent := &v1alpha1.Group{}
+		if err := c.Get(ctx, types.NamespacedName{Name: sel.MatchParentRef.Name}, parent); err != nil {
+			return nil, errors.Wrap(err, errGetParentGroup)
+		}
+		parentID = toPtrValue(meta.GetExternalName(parent))
+		if parentID == nil {
+			return nil, errors.New(errParentGroupNotReady)
+		}
+	}
+
+	l := &v1alpha1.GroupList{}
+	if err := c.List(ctx, l, client.MatchingLabels(sel.MatchLabels)); err != nil {
+		return nil, errors.Wrap(err, errListGroups)
+	}
+	for i := range l.Items {
+		g := &l.Items[i]
+		if reference.ControllersMustMatch(&sel.Selector) && !meta.HaveSameController(from, g) {
+			continue
+		}
+		if g.Spec.ForProvider.ParentID == nil || *g.Spec.ForProvider.ParentID != *parentID {
+			continue
+		}
+		return &xpv1.Reference{Name: g.GetName(), Policy: sel.Policy}, nil
+	}
+
+	if sel.Policy.IsResolutionPolicyOptional() {
+		return nil, nil
+	}
+	return nil, errors.New(errNoGroupMatches)
+}
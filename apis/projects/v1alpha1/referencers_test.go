@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func TestSelectNamespace(t *testing.T) {
+	parentID := 10
+	otherID := 20
+
+	group := func(name string, parent *int) v1alpha1.Group {
+		g := v1alpha1.Group{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		g.Spec.ForProvider.ParentID = parent
+		return g
+	}
+	list := func(groups ...v1alpha1.Group) test.MockListFn {
+		return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*v1alpha1.GroupList).Items = groups
+			return nil
+		}
+	}
+
+	type want struct {
+		ref *xpv1.Reference
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		sel  *NamespaceSelector
+		want want
+	}{
+		"NoSelector": {},
+		"NoParentMatch": {
+			sel: &NamespaceSelector{Selector: xpv1.Selector{MatchLabels: map[string]string{"a": "b"}}},
+		},
+		"MatchParentID": {
+			kube: &test.MockClient{MockList: list(group("other", &otherID), group("child", &parentID))},
+			sel:  &NamespaceSelector{MatchParentID: &parentID},
+			want: want{ref: &xpv1.Reference{Name: "child"}},
+		},
+		"MatchParentRef": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					meta.SetExternalName(obj, "20")
+					return nil
+				},
+				MockList: list(group("child", &parentID), group("other", &otherID)),
+			},
+			sel:  &NamespaceSelector{MatchParentRef: &xpv1.Reference{Name: "parent"}},
+			want: want{ref: &xpv1.Reference{Name: "other"}},
+		},
+		"ParentRefNotReady": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			sel:  &NamespaceSelector{MatchParentRef: &xpv1.Reference{Name: "parent"}},
+			want: want{err: errors.New(errParentGroupNotReady)},
+		},
+		"NoMatches": {
+			kube: &test.MockClient{MockList: list(group("other", &otherID))},
+			sel:  &NamespaceSelector{MatchParentID: &parentID},
+			want: want{err: errors.New(errNoGroupMatches)},
+		},
+		"NoMatchesOptional": {
+			kube: &test.MockClient{MockList: list(group("other", &otherID))},
+			sel: &NamespaceSelector{
+				Selector:      xpv1.Selector{Policy: &xpv1.Policy{Resolution: func() *xpv1.ResolutionPolicy { p := xpv1.ResolutionPolicyOptional; return &p }()}},
+				MatchParentID: &parentID,
+			},
+		},
+		"ListFailed": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(errors.New("boom"))},
+			sel:  &NamespaceSelector{MatchParentID: &parentID},
+			want: want{err: errors.Wrap(errors.New("boom"), errListGroups)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ref, err := selectNamespace(context.Background(), tc.kube, &Project{}, tc.sel)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ref, ref); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -101,6 +101,78 @@ var (
 	PipelineScheduleGroupVersionKind = SchemeGroupVersion.WithKind(PipelineScheduleKind)
 )
 
+// Issue type metadata
+var (
+	IssueKind             = reflect.TypeOf(Issue{}).Name()
+	IssueGroupKind        = schema.GroupKind{Group: Group, Kind: IssueKind}.String()
+	IssueKindAPIVersion   = IssueKind + "." + SchemeGroupVersion.String()
+	IssueGroupVersionKind = SchemeGroupVersion.WithKind(IssueKind)
+)
+
+// SecurityPolicyProjectLink type metadata
+var (
+	SecurityPolicyProjectLinkKind             = reflect.TypeOf(SecurityPolicyProjectLink{}).Name()
+	SecurityPolicyProjectLinkGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityPolicyProjectLinkKind}.String()
+	SecurityPolicyProjectLinkKindAPIVersion   = SecurityPolicyProjectLinkKind + "." + SchemeGroupVersion.String()
+	SecurityPolicyProjectLinkGroupVersionKind = SchemeGroupVersion.WithKind(SecurityPolicyProjectLinkKind)
+)
+
+// RegistryProtectionRule type metadata
+var (
+	RegistryProtectionRuleKind             = reflect.TypeOf(RegistryProtectionRule{}).Name()
+	RegistryProtectionRuleGroupKind        = schema.GroupKind{Group: Group, Kind: RegistryProtectionRuleKind}.String()
+	RegistryProtectionRuleKindAPIVersion   = RegistryProtectionRuleKind + "." + SchemeGroupVersion.String()
+	RegistryProtectionRuleGroupVersionKind = SchemeGroupVersion.WithKind(RegistryProtectionRuleKind)
+)
+
+// Snippet type metadata
+var (
+	SnippetKind             = reflect.TypeOf(Snippet{}).Name()
+	SnippetGroupKind        = schema.GroupKind{Group: Group, Kind: SnippetKind}.String()
+	SnippetKindAPIVersion   = SnippetKind + "." + SchemeGroupVersion.String()
+	SnippetGroupVersionKind = SchemeGroupVersion.WithKind(SnippetKind)
+)
+
+// WikiPage type metadata
+var (
+	WikiPageKind             = reflect.TypeOf(WikiPage{}).Name()
+	WikiPageGroupKind        = schema.GroupKind{Group: Group, Kind: WikiPageKind}.String()
+	WikiPageKindAPIVersion   = WikiPageKind + "." + SchemeGroupVersion.String()
+	WikiPageGroupVersionKind = SchemeGroupVersion.WithKind(WikiPageKind)
+)
+
+// Commit type metadata
+var (
+	CommitKind             = reflect.TypeOf(Commit{}).Name()
+	CommitGroupKind        = schema.GroupKind{Group: Group, Kind: CommitKind}.String()
+	CommitKindAPIVersion   = CommitKind + "." + SchemeGroupVersion.String()
+	CommitGroupVersionKind = SchemeGroupVersion.WithKind(CommitKind)
+)
+
+// FeatureFlag type metadata
+var (
+	FeatureFlagKind             = reflect.TypeOf(FeatureFlag{}).Name()
+	FeatureFlagGroupKind        = schema.GroupKind{Group: Group, Kind: FeatureFlagKind}.String()
+	FeatureFlagKindAPIVersion   = FeatureFlagKind + "." + SchemeGroupVersion.String()
+	FeatureFlagGroupVersionKind = SchemeGroupVersion.WithKind(FeatureFlagKind)
+)
+
+// FeatureFlagUserList type metadata
+var (
+	FeatureFlagUserListKind             = reflect.TypeOf(FeatureFlagUserList{}).Name()
+	FeatureFlagUserListGroupKind        = schema.GroupKind{Group: Group, Kind: FeatureFlagUserListKind}.String()
+	FeatureFlagUserListKindAPIVersion   = FeatureFlagUserListKind + "." + SchemeGroupVersion.String()
+	FeatureFlagUserListGroupVersionKind = SchemeGroupVersion.WithKind(FeatureFlagUserListKind)
+)
+
+// ProjectAlias type metadata
+var (
+	ProjectAliasKind             = reflect.TypeOf(ProjectAlias{}).Name()
+	ProjectAliasGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectAliasKind}.String()
+	ProjectAliasKindAPIVersion   = ProjectAliasKind + "." + SchemeGroupVersion.String()
+	ProjectAliasGroupVersionKind = SchemeGroupVersion.WithKind(ProjectAliasKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Project{}, &ProjectList{})
 	SchemeBuilder.Register(&Hook{}, &HookList{})
@@ -110,4 +182,13 @@ func init() {
 	SchemeBuilder.Register(&DeployKey{}, &DeployKeyList{})
 	SchemeBuilder.Register(&AccessToken{}, &AccessTokenList{})
 	SchemeBuilder.Register(&PipelineSchedule{}, &PipelineScheduleList{})
+	SchemeBuilder.Register(&Issue{}, &IssueList{})
+	SchemeBuilder.Register(&SecurityPolicyProjectLink{}, &SecurityPolicyProjectLinkList{})
+	SchemeBuilder.Register(&RegistryProtectionRule{}, &RegistryProtectionRuleList{})
+	SchemeBuilder.Register(&Snippet{}, &SnippetList{})
+	SchemeBuilder.Register(&WikiPage{}, &WikiPageList{})
+	SchemeBuilder.Register(&Commit{}, &CommitList{})
+	SchemeBuilder.Register(&FeatureFlag{}, &FeatureFlagList{})
+	SchemeBuilder.Register(&FeatureFlagUserList{}, &FeatureFlagUserListList{})
+	SchemeBuilder.Register(&ProjectAlias{}, &ProjectAliasList{})
 }
@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RegistryProtectionAccessLevel is the minimum access level a user needs to
+// push or delete protected container images.
+type RegistryProtectionAccessLevel string
+
+// List of available registry protection access levels.
+const (
+	RegistryProtectionAccessLevelMaintainer RegistryProtectionAccessLevel = "maintainer"
+	RegistryProtectionAccessLevelOwner      RegistryProtectionAccessLevel = "owner"
+	RegistryProtectionAccessLevelAdmin      RegistryProtectionAccessLevel = "admin"
+)
+
+// RegistryProtectionRuleParameters define the desired state of a Gitlab
+// container registry protection rule.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_repository_protection_rules.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type RegistryProtectionRuleParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// RepositoryPathPattern is the container repository path pattern protected
+	// by the rule, e.g. my-group/my-project/image-*. Wildcards (*) are allowed.
+	// +required
+	RepositoryPathPattern string `json:"repositoryPathPattern"`
+
+	// MinimumAccessLevelForPush is the minimum access level required to push
+	// container images matching the pattern.
+	// +optional
+	// +kubebuilder:validation:Enum=maintainer;owner;admin
+	MinimumAccessLevelForPush *RegistryProtectionAccessLevel `json:"minimumAccessLevelForPush,omitempty"`
+
+	// MinimumAccessLevelForDelete is the minimum access level required to
+	// delete container images matching the pattern.
+	// +optional
+	// +kubebuilder:validation:Enum=maintainer;owner;admin
+	MinimumAccessLevelForDelete *RegistryProtectionAccessLevel `json:"minimumAccessLevelForDelete,omitempty"`
+}
+
+// RegistryProtectionRuleObservation represents the observed state of a Gitlab
+// container registry protection rule.
+type RegistryProtectionRuleObservation struct {
+	ID        int `json:"id,omitempty"`
+	ProjectID int `json:"projectId,omitempty"`
+}
+
+// A RegistryProtectionRuleSpec defines the desired state of a RegistryProtectionRule.
+type RegistryProtectionRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RegistryProtectionRuleParameters `json:"forProvider"`
+}
+
+// A RegistryProtectionRuleStatus represents the observed state of a RegistryProtectionRule.
+type RegistryProtectionRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RegistryProtectionRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RegistryProtectionRule is a managed resource that represents a Gitlab
+// container registry protection rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="PATTERN",type="string",JSONPath=".spec.forProvider.repositoryPathPattern"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type RegistryProtectionRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegistryProtectionRuleSpec   `json:"spec"`
+	Status RegistryProtectionRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegistryProtectionRuleList contains a list of RegistryProtectionRule items
+type RegistryProtectionRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistryProtectionRule `json:"items"`
+}
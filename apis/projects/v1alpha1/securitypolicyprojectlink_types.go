@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecurityPolicyProjectLinkParameters define the desired state of the link
+// between a project and its security policy project.
+// Security policies are only available in GitLab Ultimate.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#mutationsecuritypolicyprojectassign
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type SecurityPolicyProjectLinkParameters struct {
+	// The ID or URL-encoded path of the project to link the security policy project to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// The ID or URL-encoded path of the security policy project holding the
+	// scan execution and scan result policies.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=SecurityPolicyProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=SecurityPolicyProjectIDSelector
+	SecurityPolicyProjectID *string `json:"securityPolicyProjectId,omitempty"`
+
+	// SecurityPolicyProjectIDRef is a reference to a project to retrieve its
+	// SecurityPolicyProjectID.
+	// +optional
+	SecurityPolicyProjectIDRef *xpv1.Reference `json:"securityPolicyProjectIdRef,omitempty"`
+
+	// SecurityPolicyProjectIDSelector selects reference to a project to
+	// retrieve its SecurityPolicyProjectID.
+	// +optional
+	SecurityPolicyProjectIDSelector *xpv1.Selector `json:"securityPolicyProjectIdSelector,omitempty"`
+}
+
+// SecurityPolicyProjectLinkObservation represents the observed state of the
+// link between a project and its security policy project.
+type SecurityPolicyProjectLinkObservation struct {
+	SecurityPolicyProjectID       int    `json:"securityPolicyProjectId,omitempty"`
+	SecurityPolicyProjectFullPath string `json:"securityPolicyProjectFullPath,omitempty"`
+}
+
+// A SecurityPolicyProjectLinkSpec defines the desired state of a SecurityPolicyProjectLink.
+type SecurityPolicyProjectLinkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityPolicyProjectLinkParameters `json:"forProvider"`
+}
+
+// A SecurityPolicyProjectLinkStatus represents the observed state of a SecurityPolicyProjectLink.
+type SecurityPolicyProjectLinkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecurityPolicyProjectLinkObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityPolicyProjectLink is a managed resource that links a security
+// policy project to a Gitlab project.
+// Its external name is the ID of the project the policy project is linked to.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="POLICY PROJECT",type="string",JSONPath=".spec.forProvider.securityPolicyProjectId"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type SecurityPolicyProjectLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityPolicyProjectLinkSpec   `json:"spec"`
+	Status SecurityPolicyProjectLinkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityPolicyProjectLinkList contains a list of SecurityPolicyProjectLink items
+type SecurityPolicyProjectLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityPolicyProjectLink `json:"items"`
+}
@@ -49,6 +49,13 @@ type VariableParameters struct {
 	// +optional
 	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
 
+	// ProjectPath is the full path of the project, e.g. my-group/my-project.
+	// It is resolved to the project ID through the GitLab API when ProjectID
+	// is not set, which allows referencing projects not managed by Crossplane.
+	// +optional
+	// +immutable
+	ProjectPath *string `json:"projectPath,omitempty"`
+
 	// Key for the variable.
 	// +kubebuilder:validation:Pattern:=^[a-zA-Z0-9\_]+$
 	// +kubebuilder:validation:MaxLength:=255
@@ -95,10 +102,34 @@ type VariableSpec struct {
 	ForProvider       VariableParameters `json:"forProvider"`
 }
 
+// VariableObservation represents the observed state of a Gitlab Project CI
+// Variable as stored by Gitlab.
+type VariableObservation struct {
+	// VariableType is the type of the variable.
+	VariableType VariableType `json:"variableType,omitempty"`
+
+	// Protected indicates whether the variable is protected.
+	Protected bool `json:"protected,omitempty"`
+
+	// Masked indicates whether the variable is masked.
+	Masked bool `json:"masked,omitempty"`
+
+	// Raw indicates whether variable expansion is disabled.
+	Raw bool `json:"raw,omitempty"`
+
+	// EnvironmentScope is the environment scope the variable applies to.
+	EnvironmentScope string `json:"environmentScope,omitempty"`
+
+	// ValueChecksum is the hex encoded SHA-256 checksum of the stored value.
+	// It allows detecting value drift without exposing the value itself.
+	ValueChecksum string `json:"valueChecksum,omitempty"`
+}
+
 // A VariableStatus represents the observed state of a Gitlab Project CI
 // Variable.
 type VariableStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VariableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -107,6 +138,8 @@ type VariableStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".status.atProvider.environmentScope"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
 type Variable struct {
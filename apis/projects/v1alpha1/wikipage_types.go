@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WikiFormatValue represents the available wiki page formats.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/wikis.html
+// +kubebuilder:validation:Enum=markdown;rdoc;asciidoc;org
+type WikiFormatValue string
+
+// List of available wiki page formats.
+const (
+	WikiFormatMarkdown WikiFormatValue = "markdown"
+	WikiFormatRDoc     WikiFormatValue = "rdoc"
+	WikiFormatASCIIDoc WikiFormatValue = "asciidoc"
+	WikiFormatOrg      WikiFormatValue = "org"
+)
+
+// WikiPageParameters define the desired state of a Gitlab project wiki page.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/wikis.html
+// At least 1 of [ProjectID, ProjectIDRef, ProjectIDSelector] required.
+type WikiPageParameters struct {
+	// The ID or URL-encoded path of the project owned by the authenticated user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1.Project
+	// +crossplane:generate:reference:refFieldName=ProjectIDRef
+	// +crossplane:generate:reference:selectorFieldName=ProjectIDSelector
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// ProjectIDRef is a reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDRef *xpv1.Reference `json:"projectIdRef,omitempty"`
+
+	// ProjectIDSelector selects reference to a project to retrieve its ProjectID.
+	// +optional
+	// +immutable
+	ProjectIDSelector *xpv1.Selector `json:"projectIdSelector,omitempty"`
+
+	// Title of the wiki page. The page slug is derived from it, so it can't
+	// be changed once the page is created.
+	// +immutable
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="title is immutable"
+	Title string `json:"title"`
+
+	// Content of the wiki page.
+	Content string `json:"content"`
+
+	// Format of the wiki page. Defaults to markdown.
+	// +optional
+	Format *WikiFormatValue `json:"format,omitempty"`
+}
+
+// WikiPageObservation represents the observed state of a Gitlab project wiki
+// page.
+type WikiPageObservation struct {
+	Slug string `json:"slug,omitempty"`
+}
+
+// A WikiPageSpec defines the desired state of a WikiPage.
+type WikiPageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WikiPageParameters `json:"forProvider"`
+}
+
+// A WikiPageStatus represents the observed state of a WikiPage.
+type WikiPageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WikiPageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WikiPage is a managed resource that represents a Gitlab project wiki page.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SLUG",type="string",JSONPath=".status.atProvider.slug"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gitlab}
+type WikiPage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WikiPageSpec   `json:"spec"`
+	Status WikiPageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WikiPageList contains a list of WikiPage items
+type WikiPageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WikiPage `json:"items"`
+}
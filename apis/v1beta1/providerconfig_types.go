@@ -49,6 +49,22 @@ type ProviderConfigSpec struct {
 	// InsecureSkipVerify ignores self signed TLS certificates when connecting
 	// to Gitlab.
 	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
+
+	// PerPage is the page size used when listing resources in Gitlab. Gitlab
+	// does not return more than 100 items per page.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=100
+	PerPage *int `json:"perPage,omitempty"`
+
+	// Sudo is the username or ID of a user to impersonate on every request
+	// made with this ProviderConfig, so that resources are created on behalf
+	// of that user. The credentials must belong to an administrator and have
+	// the sudo scope.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Sudo *string `json:"sudo,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
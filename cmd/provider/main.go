@@ -43,6 +43,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/apis"
 	"github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
@@ -61,6 +62,7 @@ func main() {
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+		dryRun                     = app.Flag("dry-run", "Observe resources and report the changes that would be made without creating, updating or deleting anything in GitLab.").Default("false").Envar("DRY_RUN").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -106,6 +108,7 @@ func main() {
 
 	metrics.Registry.MustRegister(mm)
 	metrics.Registry.MustRegister(sm)
+	metrics.Registry.MustRegister(clients.DefaultAPIMetrics)
 
 	mo := xpcontroller.MetricOptions{
 		PollStateMetricInterval: *pollStateMetricInterval,
@@ -148,6 +151,11 @@ func main() {
 		log.Info("Alpha feature enabled", "flag", features.EnableAlphaManagementPolicies)
 	}
 
+	if *dryRun {
+		o.Features.Enable(features.EnableDryRun)
+		log.Info("Dry-run mode enabled, no changes will be made in GitLab", "flag", features.EnableDryRun)
+	}
+
 	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup Gitlab controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// TypeTokenExpiring indicates whether an access token expires within its
+// warning window. Operators can alert on this condition to renew tokens that
+// are not rotated automatically.
+const TypeTokenExpiring xpv1.ConditionType = "TokenExpiring"
+
+// Reasons an access token does or does not expire soon.
+const (
+	ReasonTokenExpiring    xpv1.ConditionReason = "ExpiresSoon"
+	ReasonTokenNotExpiring xpv1.ConditionReason = "NotExpiring"
+)
+
+// DefaultExpiryWarningDays is the number of days before its expiration date
+// at which an access token is reported as expiring, unless configured
+// otherwise.
+const DefaultExpiryWarningDays = 7
+
+// TokenExpiring returns the TokenExpiring condition of an access token that
+// expires at the supplied time. Tokens without an expiration date never
+// expire.
+func TokenExpiring(expiresAt *gitlab.ISOTime, warningDays *int, now time.Time) xpv1.Condition {
+	c := xpv1.Condition{
+		Type:               TypeTokenExpiring,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTokenNotExpiring,
+	}
+	if expiresAt == nil {
+		return c
+	}
+
+	expires := time.Time(*expiresAt)
+	warnAt := expires.AddDate(0, 0, -ptr.Deref(warningDays, DefaultExpiryWarningDays))
+	if now.Before(warnAt) {
+		return c
+	}
+
+	c.Status = corev1.ConditionTrue
+	c.Reason = ReasonTokenExpiring
+	c.Message = fmt.Sprintf("Access token expires at %s", expires.Format(time.RFC3339))
+	return c
+}
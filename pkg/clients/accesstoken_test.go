@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	gitlab "github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestTokenExpiring(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	in := func(days int) *gitlab.ISOTime {
+		at := gitlab.ISOTime(now.AddDate(0, 0, days))
+		return &at
+	}
+	notExpiring := xpv1.Condition{Type: TypeTokenExpiring, Status: corev1.ConditionFalse, Reason: ReasonTokenNotExpiring}
+	expiring := func(days int) xpv1.Condition {
+		return xpv1.Condition{
+			Type:    TypeTokenExpiring,
+			Status:  corev1.ConditionTrue,
+			Reason:  ReasonTokenExpiring,
+			Message: "Access token expires at " + now.AddDate(0, 0, days).Format(time.RFC3339),
+		}
+	}
+
+	cases := map[string]struct {
+		expiresAt   *gitlab.ISOTime
+		warningDays *int
+		want        xpv1.Condition
+	}{
+		"NoExpiry": {
+			want: notExpiring,
+		},
+		"OutsideDefaultWindow": {
+			expiresAt: in(30),
+			want:      notExpiring,
+		},
+		"InsideDefaultWindow": {
+			expiresAt: in(DefaultExpiryWarningDays),
+			want:      expiring(DefaultExpiryWarningDays),
+		},
+		"InsideConfiguredWindow": {
+			expiresAt:   in(30),
+			warningDays: gitlab.Ptr(45),
+			want:        expiring(30),
+		},
+		"Expired": {
+			expiresAt: in(-1),
+			want:      expiring(-1),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TokenExpiring(tc.expiresAt, tc.warningDays, now)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("TokenExpiring(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
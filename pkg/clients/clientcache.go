@@ -0,0 +1,95 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// DefaultClientCache holds the clients created by NewClient for each
+// ProviderConfig.
+var DefaultClientCache = NewClientCache()
+
+type clientCacheEntry struct {
+	fingerprint string
+	client      *gitlab.Client
+	transport   *http.Transport
+}
+
+// ClientCache keeps one GitLab client per ProviderConfig, so that its
+// connections and rate limiter are shared by all controllers. A client is
+// rebuilt as soon as it is requested with different credentials, e.g. after
+// the token in the credentials secret was rotated.
+type ClientCache struct {
+	mu      sync.Mutex
+	entries map[string]clientCacheEntry
+}
+
+// NewClientCache returns an empty ClientCache.
+func NewClientCache() *ClientCache {
+	return &ClientCache{entries: map[string]clientCacheEntry{}}
+}
+
+// Get returns the client of the ProviderConfig of cfg, calling build if there
+// is none yet or if it was built from a different configuration.
+func (c *ClientCache) Get(cfg Config, build func(Config) (*gitlab.Client, *http.Transport)) *gitlab.Client {
+	fp := clientFingerprint(cfg)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[cfg.ProviderConfig]
+	if ok && e.fingerprint == fp {
+		return e.client
+	}
+	if ok {
+		e.transport.CloseIdleConnections()
+	}
+
+	cl, t := build(cfg)
+	c.entries[cfg.ProviderConfig] = clientCacheEntry{fingerprint: fp, client: cl, transport: t}
+	return cl
+}
+
+// Invalidate drops the client of the named ProviderConfig, e.g. once it has
+// been deleted.
+func (c *ClientCache) Invalidate(providerConfig string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[providerConfig]; ok {
+		e.transport.CloseIdleConnections()
+		delete(c.entries, providerConfig)
+	}
+}
+
+// clientFingerprint identifies the parts of cfg a client is built from,
+// without keeping the token itself.
+func clientFingerprint(cfg Config) string {
+	return ValueChecksum(strings.Join([]string{
+		cfg.BaseURL,
+		cfg.Token,
+		string(cfg.AuthMethod),
+		cfg.Sudo,
+		strconv.FormatBool(cfg.InsecureSkipVerify),
+	}, "|"))
+}
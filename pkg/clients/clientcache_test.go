@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"testing"
+
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestClientCache(t *testing.T) {
+	type call struct {
+		cfg        Config
+		invalidate bool
+	}
+
+	cases := map[string]struct {
+		calls  []call
+		builds int
+	}{
+		"Cached": {
+			calls: []call{
+				{cfg: Config{ProviderConfig: "default", Token: "a"}},
+				{cfg: Config{ProviderConfig: "default", Token: "a"}},
+			},
+			builds: 1,
+		},
+		"TokenRotated": {
+			calls: []call{
+				{cfg: Config{ProviderConfig: "default", Token: "a"}},
+				{cfg: Config{ProviderConfig: "default", Token: "b"}},
+				{cfg: Config{ProviderConfig: "default", Token: "b"}},
+			},
+			builds: 2,
+		},
+		"BaseURLChanged": {
+			calls: []call{
+				{cfg: Config{ProviderConfig: "default", Token: "a", BaseURL: "https://gitlab.com/"}},
+				{cfg: Config{ProviderConfig: "default", Token: "a", BaseURL: "https://gitlab.example.com/"}},
+			},
+			builds: 2,
+		},
+		"SudoChanged": {
+			calls: []call{
+				{cfg: Config{ProviderConfig: "default", Token: "a"}},
+				{cfg: Config{ProviderConfig: "default", Token: "a", Sudo: "bot"}},
+			},
+			builds: 2,
+		},
+		"DifferentProviderConfigs": {
+			calls: []call{
+				{cfg: Config{ProviderConfig: "default", Token: "a"}},
+				{cfg: Config{ProviderConfig: "other", Token: "a"}},
+			},
+			builds: 2,
+		},
+		"Invalidated": {
+			calls: []call{
+				{cfg: Config{ProviderConfig: "default", Token: "a"}},
+				{cfg: Config{ProviderConfig: "default"}, invalidate: true},
+				{cfg: Config{ProviderConfig: "default", Token: "a"}},
+			},
+			builds: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClientCache()
+			builds := 0
+			build := func(cfg Config) (*gitlab.Client, *http.Transport) {
+				builds++
+				return newClient(cfg)
+			}
+
+			for _, cl := range tc.calls {
+				if cl.invalidate {
+					c.Invalidate(cl.cfg.ProviderConfig)
+					continue
+				}
+				c.Get(cl.cfg, build)
+			}
+
+			if tc.builds != builds {
+				t.Errorf("Get(...): want %d builds, got %d", tc.builds, builds)
+			}
+		})
+	}
+}
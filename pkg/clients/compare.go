@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// gitlabTag is the struct tag that maps a parameter to the field of the
+// GitLab API object it is compared with. A parameter tagged `gitlab:"-"` is
+// excluded from the comparison, typically because GitLab does not return it
+// verbatim or because it needs a hand-written comparison.
+const gitlabTag = "gitlab"
+
+// Diff compares the parameters of a managed resource with the GitLab API
+// object they were applied to and returns the JSON names of the parameters
+// that differ. Each parameter is compared with the GitLab field of the same
+// name, or the one named by its gitlab tag. Parameters that are nil are left
+// to GitLab and never differ. Parameters without a GitLab counterpart, or
+// whose type cannot be compared with it, are skipped. Nested structs differ
+// if any of their own parameters differ.
+func Diff(params, observed interface{}) []string {
+	return diff(reflect.ValueOf(params), reflect.ValueOf(observed))
+}
+
+func diff(p, g reflect.Value) []string {
+	p, g = reflect.Indirect(p), reflect.Indirect(g)
+	if p.Kind() != reflect.Struct || g.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []string
+	for i := 0; i < p.NumField(); i++ {
+		f := p.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup(gitlabTag); ok {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+		gv := g.FieldByName(name)
+		if !gv.IsValid() {
+			continue
+		}
+		if !equal(p.Field(i), gv) {
+			fields = append(fields, jsonName(f))
+		}
+	}
+	return fields
+}
+
+func equal(p, g reflect.Value) bool {
+	if isNil(p) {
+		return true
+	}
+	p = reflect.Indirect(p)
+	if g.Kind() == reflect.Ptr {
+		if g.IsNil() {
+			return false
+		}
+		g = g.Elem()
+	}
+
+	switch {
+	case p.Kind() == reflect.Struct && g.Kind() == reflect.Struct:
+		return len(diff(p, g)) == 0
+	case p.Kind() == reflect.Slice && p.Type() == g.Type():
+		return cmp.Equal(p.Interface(), g.Interface(), cmpopts.EquateEmpty())
+	case p.Kind() == g.Kind() && p.Type().ConvertibleTo(g.Type()):
+		return cmp.Equal(p.Convert(g.Type()).Interface(), g.Interface())
+	}
+	return true
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() { //nolint:exhaustive // Only nillable kinds are relevant.
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
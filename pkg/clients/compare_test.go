@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+type diffLevel string
+
+type diffNested struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type diffParameters struct {
+	Name     *string     `json:"name,omitempty"`
+	Path     string      `json:"path"`
+	Level    *diffLevel  `json:"level,omitempty"`
+	Tags     []string    `json:"tags,omitempty"`
+	Public   *bool       `json:"public,omitempty" gitlab:"PublicJobs"`
+	Secret   *string     `json:"secret,omitempty" gitlab:"-"`
+	Nested   *diffNested `json:"nested,omitempty" gitlab:"Policy"`
+	Unmapped *int        `json:"unmapped,omitempty"`
+}
+
+type diffPolicy struct {
+	Enabled bool
+}
+
+type diffObserved struct {
+	Name       string
+	Path       string
+	Level      string
+	Tags       []string
+	PublicJobs bool
+	Secret     string
+	Policy     *diffPolicy
+}
+
+func TestDiff(t *testing.T) {
+	observed := &diffObserved{
+		Name:       "name",
+		Path:       "path",
+		Level:      "private",
+		PublicJobs: true,
+		Secret:     "*****",
+		Policy:     &diffPolicy{Enabled: true},
+	}
+	private := diffLevel("private")
+	public := diffLevel("public")
+
+	cases := map[string]struct {
+		reason string
+		p      *diffParameters
+		want   []string
+	}{
+		"Unset": {
+			reason: "Nil parameters should not differ.",
+			p:      &diffParameters{Path: "path"},
+		},
+		"UpToDate": {
+			reason: "Parameters matching the observed fields should not differ.",
+			p: &diffParameters{
+				Name:   gitlab.Ptr("name"),
+				Path:   "path",
+				Level:  &private,
+				Tags:   []string{},
+				Public: gitlab.Ptr(true),
+				Secret: gitlab.Ptr("secret"),
+				Nested: &diffNested{Enabled: gitlab.Ptr(true)},
+			},
+		},
+		"Differs": {
+			reason: "Differing parameters should be returned by their JSON name.",
+			p: &diffParameters{
+				Name:     gitlab.Ptr("other"),
+				Path:     "other",
+				Level:    &public,
+				Tags:     []string{"tag"},
+				Public:   gitlab.Ptr(false),
+				Secret:   gitlab.Ptr("other"),
+				Nested:   &diffNested{Enabled: gitlab.Ptr(false)},
+				Unmapped: gitlab.Ptr(1),
+			},
+			want: []string{"name", "path", "level", "tags", "public", "nested"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Diff(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDiff(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDiffNilObserved(t *testing.T) {
+	p := &diffParameters{Nested: &diffNested{Enabled: gitlab.Ptr(true)}}
+	got := Diff(p, &diffObserved{})
+	if diff := cmp.Diff([]string{"nested"}, got); diff != "" {
+		t.Errorf("Diff(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalname parses and formats the external names of the
+// provider's managed resources.
+//
+// Most kinds use the numeric GitLab ID of the resource as external name.
+// Projects and groups additionally accept their full path, e.g.
+// my-group/my-project, which is resolved and replaced by the ID on the first
+// observation. Kinds that are only unique within a parent and have no ID of
+// their own use composite names whose parts are joined by Separator, e.g.
+// variables are named key[:environment_scope].
+package externalname
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Separator joins the parts of a composite external name. It cannot occur
+// in GitLab paths, IDs or variable keys.
+const Separator = ":"
+
+// DefaultEnvironmentScope is the environment scope GitLab assigns to
+// variables created without one. It is omitted from variable names.
+const DefaultEnvironmentScope = "*"
+
+const (
+	errEmptyPath     = "external-name path is empty"
+	errInvalidPath   = "external-name path %q must not start or end with a slash"
+	errPartsMismatch = "external-name %q must have %d parts separated by %q"
+	errInvalidKey    = "external-name %q does not start with a valid variable key"
+)
+
+var variableKey = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// ParseID parses an external name that holds a numeric GitLab ID.
+func ParseID(name string) (int, error) {
+	return strconv.Atoi(name)
+}
+
+// FormatID formats a numeric GitLab ID as external name.
+func FormatID(id int) string {
+	return strconv.Itoa(id)
+}
+
+// PathOrID identifies a project or group either by its numeric ID or by its
+// full path.
+type PathOrID struct {
+	ID   int
+	Path string
+}
+
+// ParsePathOrID parses the external name of a project or group. Names
+// consisting only of digits are IDs, anything else is a full path.
+func ParsePathOrID(name string) (PathOrID, error) {
+	if id, err := ParseID(name); err == nil {
+		return PathOrID{ID: id}, nil
+	}
+	if name == "" {
+		return PathOrID{}, errors.New(errEmptyPath)
+	}
+	if strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") || strings.Contains(name, Separator) {
+		return PathOrID{}, errors.Errorf(errInvalidPath, name)
+	}
+	return PathOrID{Path: name}, nil
+}
+
+// IsPath reports whether the external name was a path rather than an ID.
+func (p PathOrID) IsPath() bool {
+	return p.Path != ""
+}
+
+// PID returns the identifier in the form accepted by the GitLab client for
+// project and group IDs.
+func (p PathOrID) PID() interface{} {
+	if p.IsPath() {
+		return p.Path
+	}
+	return p.ID
+}
+
+// String formats the identifier as external name.
+func (p PathOrID) String() string {
+	if p.IsPath() {
+		return p.Path
+	}
+	return FormatID(p.ID)
+}
+
+// ParseComposite splits a composite external name into exactly n parts.
+func ParseComposite(name string, n int) ([]string, error) {
+	parts := strings.SplitN(name, Separator, n)
+	if len(parts) != n {
+		return nil, errors.Errorf(errPartsMismatch, name, n, Separator)
+	}
+	return parts, nil
+}
+
+// FormatComposite joins parts into a composite external name.
+func FormatComposite(parts ...interface{}) string {
+	s := make([]string, len(parts))
+	for i, p := range parts {
+		s[i] = fmt.Sprint(p)
+	}
+	return strings.Join(s, Separator)
+}
+
+// ParseVariable parses the external name of a project or group variable,
+// key[:environment_scope]. A missing scope is the default scope.
+func ParseVariable(name string) (key, scope string, err error) {
+	key, scope, found := strings.Cut(name, Separator)
+	if !variableKey.MatchString(key) {
+		return "", "", errors.Errorf(errInvalidKey, name)
+	}
+	if !found || scope == "" {
+		scope = DefaultEnvironmentScope
+	}
+	return key, scope, nil
+}
+
+// FormatVariable formats the external name of a project or group variable.
+// The scope is omitted when it is nil or the default scope.
+func FormatVariable(key string, scope *string) string {
+	if scope == nil || *scope == "" || *scope == DefaultEnvironmentScope {
+		return key
+	}
+	return FormatComposite(key, *scope)
+}
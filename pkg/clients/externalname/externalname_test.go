@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalname
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestParsePathOrID(t *testing.T) {
+	type want struct {
+		ref PathOrID
+		pid interface{}
+		err error
+	}
+	cases := map[string]struct {
+		name string
+		want want
+	}{
+		"ID": {
+			name: "42",
+			want: want{ref: PathOrID{ID: 42}, pid: 42},
+		},
+		"Path": {
+			name: "my-group/my-project",
+			want: want{ref: PathOrID{Path: "my-group/my-project"}, pid: "my-group/my-project"},
+		},
+		"Empty": {
+			name: "",
+			want: want{err: errors.New(errEmptyPath)},
+		},
+		"LeadingSlash": {
+			name: "/my-group",
+			want: want{err: errors.Errorf(errInvalidPath, "/my-group")},
+		},
+		"Composite": {
+			name: "my-group:1",
+			want: want{err: errors.Errorf(errInvalidPath, "my-group:1")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParsePathOrID(tc.name)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ref, got); diff != "" {
+				t.Errorf("ref: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.pid, got.PID()); diff != "" {
+				t.Errorf("pid: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.name, got.String()); diff != "" {
+				t.Errorf("string: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComposite(t *testing.T) {
+	type want struct {
+		parts []string
+		err   error
+	}
+	cases := map[string]struct {
+		name string
+		n    int
+		want want
+	}{
+		"TwoParts": {
+			name: FormatComposite(12, "KEY"),
+			n:    2,
+			want: want{parts: []string{"12", "KEY"}},
+		},
+		"LastPartKeepsSeparator": {
+			name: "12:KEY:production",
+			n:    2,
+			want: want{parts: []string{"12", "KEY:production"}},
+		},
+		"TooFewParts": {
+			name: "12",
+			n:    2,
+			want: want{err: errors.Errorf(errPartsMismatch, "12", 2, Separator)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseComposite(tc.name, tc.n)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.parts, got); diff != "" {
+				t.Errorf("parts: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVariable(t *testing.T) {
+	production := "production"
+	type want struct {
+		key   string
+		scope string
+		name  string
+		err   error
+	}
+	cases := map[string]struct {
+		name string
+		want want
+	}{
+		"KeyOnly": {
+			name: "API_TOKEN",
+			want: want{key: "API_TOKEN", scope: DefaultEnvironmentScope, name: "API_TOKEN"},
+		},
+		"DefaultScope": {
+			name: "API_TOKEN:*",
+			want: want{key: "API_TOKEN", scope: DefaultEnvironmentScope, name: "API_TOKEN"},
+		},
+		"Scope": {
+			name: FormatVariable("API_TOKEN", &production),
+			want: want{key: "API_TOKEN", scope: production, name: "API_TOKEN:production"},
+		},
+		"WildcardScope": {
+			name: "API_TOKEN:review/*",
+			want: want{key: "API_TOKEN", scope: "review/*", name: "API_TOKEN:review/*"},
+		},
+		"InvalidKey": {
+			name: "my-variable",
+			want: want{err: errors.Errorf(errInvalidKey, "my-variable")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			key, scope, err := ParseVariable(tc.name)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.key, key); diff != "" {
+				t.Errorf("key: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.scope, scope); diff != "" {
+				t.Errorf("scope: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.name, FormatVariable(key, &scope)); diff != "" {
+				t.Errorf("name: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,7 +18,9 @@ package clients
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"time"
@@ -51,28 +53,44 @@ type Config struct {
 	BaseURL            string
 	InsecureSkipVerify bool
 	AuthMethod         v1beta1.AuthType
+	PerPage            int
+	Sudo               string
+	ProviderConfig     string
 }
 
 // NewClient creates new Gitlab Client with provided Gitlab Configurations/Credentials.
+// Clients for a ProviderConfig are shared through DefaultClientCache, which
+// rebuilds them once its credentials change.
 func NewClient(c Config) *gitlab.Client {
+	if c.ProviderConfig == "" {
+		cl, _ := newClient(c)
+		return cl
+	}
+	return DefaultClientCache.Get(c, newClient)
+}
+
+func newClient(c Config) (*gitlab.Client, *http.Transport) {
 	var cl *gitlab.Client
 	var err error
 	options := []gitlab.ClientOptionFunc{}
 	if c.BaseURL != "" {
 		options = append(options, gitlab.WithBaseURL(c.BaseURL))
 	}
+	transport := cleanhttp.DefaultPooledTransport()
 	if c.InsecureSkipVerify {
-		transport := cleanhttp.DefaultPooledTransport()
 		if transport.TLSClientConfig == nil {
 			transport.TLSClientConfig = &tls.Config{
 				MinVersion: tls.VersionTLS12,
 			}
 		}
 		transport.TLSClientConfig.InsecureSkipVerify = true
-		httpclient := &http.Client{
-			Transport: transport,
-		}
-		options = append(options, gitlab.WithHTTPClient(httpclient))
+	}
+	httpclient := &http.Client{
+		Transport: DefaultAPIMetrics.InstrumentRoundTripper(c.ProviderConfig, transport),
+	}
+	options = append(options, gitlab.WithHTTPClient(httpclient))
+	if c.Sudo != "" {
+		options = append(options, gitlab.WithRequestOptions(gitlab.WithSudo(c.Sudo)))
 	}
 
 	switch c.AuthMethod {
@@ -81,7 +99,7 @@ func NewClient(c Config) *gitlab.Client {
 		if err = json.Unmarshal([]byte(c.Token), ba); err != nil {
 			panic(err)
 		}
-		cl, err = gitlab.NewBasicAuthClient(ba.Username, ba.Password, gitlab.WithBaseURL(c.BaseURL))
+		cl, err = gitlab.NewBasicAuthClient(ba.Username, ba.Password, options...)
 	case v1beta1.JobToken:
 		cl, err = gitlab.NewJobClient(c.Token, options...)
 	case v1beta1.OAuthToken:
@@ -95,7 +113,7 @@ func NewClient(c Config) *gitlab.Client {
 		panic(err)
 	}
 
-	return cl
+	return cl, transport
 }
 
 // GetConfig constructs a Config that can be used to authenticate to Gitlab
@@ -121,6 +139,12 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	return ConfigFromProviderConfig(ctx, c, pc)
+}
+
+// ConfigFromProviderConfig produces a config that can be used to authenticate
+// to Gitlab with the credentials of the supplied ProviderConfig.
+func ConfigFromProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceSecret:
 		csr := pc.Spec.Credentials.SecretRef
@@ -135,6 +159,9 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 			BaseURL:            pc.Spec.BaseURL,
 			Token:              string(s.Data[csr.Key]),
 			InsecureSkipVerify: ptr.Deref(pc.Spec.InsecureSkipVerify, false),
+			PerPage:            ptr.Deref(pc.Spec.PerPage, DefaultPerPage),
+			Sudo:               ptr.Deref(pc.Spec.Sudo, ""),
+			ProviderConfig:     pc.Name,
 		}, nil
 	default:
 		return nil, errors.Errorf("credentials source %s is not currently supported", s)
@@ -177,6 +204,15 @@ func LateInitializeMergeMethodValue(in *v1alpha1.MergeMethodValue, from gitlab.M
 	return in
 }
 
+// LateInitializeSquashOptionValue returns in if it's non-nil, otherwise returns from
+// which is the backup for the cases in is nil.
+func LateInitializeSquashOptionValue(in *v1alpha1.SquashOptionValue, from gitlab.SquashOptionValue) *v1alpha1.SquashOptionValue {
+	if in == nil && from != "" {
+		return (*v1alpha1.SquashOptionValue)(&from)
+	}
+	return in
+}
+
 // VisibilityValueV1alpha1ToGitlab converts *v1alpha1.VisibilityValue to *gitlab.VisibilityValue
 func VisibilityValueV1alpha1ToGitlab(from *v1alpha1.VisibilityValue) *gitlab.VisibilityValue {
 	return (*gitlab.VisibilityValue)(from)
@@ -212,6 +248,11 @@ func MergeMethodStringToGitlab(from string) *gitlab.MergeMethodValue {
 	return (*gitlab.MergeMethodValue)(&from)
 }
 
+// SquashOptionV1alpha1ToGitlab converts *v1alpha1.SquashOptionValue to *gitlab.SquashOptionValue
+func SquashOptionV1alpha1ToGitlab(from *v1alpha1.SquashOptionValue) *gitlab.SquashOptionValue {
+	return (*gitlab.SquashOptionValue)(from)
+}
+
 // StringToPtr converts string to *string
 func StringToPtr(s string) *string {
 	if s == "" {
@@ -258,6 +299,16 @@ func IsResponseNotFound(res *gitlab.Response) bool {
 	return false
 }
 
+// IsErrorForbidden checks whether the error was caused by GitLab rejecting
+// the request with 401 Unauthorized or 403 Forbidden.
+func IsErrorForbidden(err error) bool {
+	var errResp *gitlab.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return false
+	}
+	return errResp.Response.StatusCode == http.StatusUnauthorized || errResp.Response.StatusCode == http.StatusForbidden
+}
+
 // TimeToMetaTime returns nil if parameter is nil, otherwise metav1.Time value
 func TimeToMetaTime(t *time.Time) *metav1.Time {
 	if t == nil {
@@ -265,3 +316,14 @@ func TimeToMetaTime(t *time.Time) *metav1.Time {
 	}
 	return &metav1.Time{Time: *t}
 }
+
+// ValueChecksum returns the hex encoded SHA-256 checksum of a value, or an
+// empty string if the value is empty. It is used to surface sensitive values
+// in a resource status without exposing them.
+func ValueChecksum(v string) string {
+	if v == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+const (
+	graphQLPath = "api/graphql"
+
+	errGraphQL = "graphql request failed"
+)
+
+// GraphQLRequest is the body of a GitLab GraphQL request.
+type GraphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []graphQLError  `json:"errors"`
+}
+
+// DoGraphQL sends a query to the GitLab GraphQL API of the instance the
+// given client is configured for and decodes the returned data into out.
+// Some GitLab APIs are only available through GraphQL, which go-gitlab
+// does not support.
+func DoGraphQL(c *gitlab.Client, req GraphQLRequest, out interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	options = append(options, withGraphQLPath(c))
+	r, err := c.NewRequest(http.MethodPost, "", req, options)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &graphQLResponse{}
+	resp, err := c.Do(r, res)
+	if err != nil {
+		return resp, err
+	}
+	if len(res.Errors) > 0 {
+		msgs := make([]string, 0, len(res.Errors))
+		for _, e := range res.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return resp, errors.Errorf("%s: %s", errGraphQL, strings.Join(msgs, ", "))
+	}
+	if out == nil || len(res.Data) == 0 {
+		return resp, nil
+	}
+	return resp, json.Unmarshal(res.Data, out)
+}
+
+// withGraphQLPath points the request at the GraphQL endpoint, which lives
+// next to the versioned REST API, e.g. /api/graphql instead of /api/v4/.
+func withGraphQLPath(c *gitlab.Client) gitlab.RequestOptionFunc {
+	return func(r *retryablehttp.Request) error {
+		base := c.BaseURL().Path
+		if i := strings.LastIndex(base, "api/"); i >= 0 {
+			base = base[:i]
+		}
+		r.URL.Path = base + graphQLPath
+		r.URL.RawPath = ""
+		return nil
+	}
+}
+
+// GraphQLProjectGID returns the GraphQL global ID of a project.
+func GraphQLProjectGID(id int) string {
+	return "gid://gitlab/Project/" + strconv.Itoa(id)
+}
+
+// ParseGraphQLGID returns the numeric ID of a GraphQL global ID such as
+// gid://gitlab/Project/42.
+func ParseGraphQLGID(gid string) (int, error) {
+	return strconv.Atoi(gid[strings.LastIndex(gid, "/")+1:])
+}
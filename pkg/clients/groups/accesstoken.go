@@ -18,8 +18,10 @@ package groups
 
 import (
 	"strings"
+	"time"
 
 	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
@@ -29,6 +31,7 @@ import (
 type AccessTokenClient interface {
 	GetGroupAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
 	CreateGroupAccessToken(pid interface{}, opt *gitlab.CreateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
+	RotateGroupAccessToken(pid interface{}, id int, opt *gitlab.RotateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
 	RevokeGroupAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 }
 
@@ -55,6 +58,8 @@ func GenerateCreateGroupAccessTokenOptions(name string, p *v1alpha1.AccessTokenP
 
 	if p.ExpiresAt != nil {
 		accesstoken.ExpiresAt = (*gitlab.ISOTime)(&p.ExpiresAt.Time)
+	} else if p.ExpiresAtPolicy != nil {
+		accesstoken.ExpiresAt = expiresAtFromPolicy(p.ExpiresAtPolicy, time.Now())
 	}
 
 	if p.AccessLevel != nil {
@@ -63,3 +68,47 @@ func GenerateCreateGroupAccessTokenOptions(name string, p *v1alpha1.AccessTokenP
 
 	return accesstoken
 }
+
+// GenerateRotateGroupAccessTokenOptions generates access token rotation options
+// from the expiresAt policy.
+func GenerateRotateGroupAccessTokenOptions(p *v1alpha1.AccessTokenParameters, now time.Time) *gitlab.RotateGroupAccessTokenOptions {
+	opts := &gitlab.RotateGroupAccessTokenOptions{}
+	if p.ExpiresAtPolicy != nil {
+		opts.ExpiresAt = expiresAtFromPolicy(p.ExpiresAtPolicy, now)
+	}
+	return opts
+}
+
+// GenerateGroupAccessTokenObservation is used to produce
+// v1alpha1.AccessTokenObservation from gitlab.GroupAccessToken.
+func GenerateGroupAccessTokenObservation(at *gitlab.GroupAccessToken) v1alpha1.AccessTokenObservation {
+	if at == nil {
+		return v1alpha1.AccessTokenObservation{}
+	}
+
+	o := v1alpha1.AccessTokenObservation{
+		TokenID:    gitlab.Ptr(at.ID),
+		LastUsedAt: clients.TimeToMetaTime(at.LastUsedAt),
+		Active:     at.Active,
+		Revoked:    at.Revoked,
+	}
+	if at.ExpiresAt != nil {
+		o.ExpiresAt = &metav1.Time{Time: time.Time(*at.ExpiresAt)}
+	}
+	return o
+}
+
+// IsGroupAccessTokenExpiring returns true if the expiresAt policy requires the
+// access token to be rotated at the given time.
+func IsGroupAccessTokenExpiring(p *v1alpha1.AccessTokenParameters, at *gitlab.GroupAccessToken, now time.Time) bool {
+	if p.ExpiresAtPolicy == nil || at == nil || at.ExpiresAt == nil {
+		return false
+	}
+	rotateAt := time.Time(*at.ExpiresAt).AddDate(0, 0, -p.ExpiresAtPolicy.RotateDaysBefore)
+	return !now.Before(rotateAt)
+}
+
+func expiresAtFromPolicy(p *v1alpha1.ExpiresAtPolicy, now time.Time) *gitlab.ISOTime {
+	expiresAt := gitlab.ISOTime(now.AddDate(0, 0, p.ValidityDays))
+	return &expiresAt
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// EpicClient defines Gitlab Epic service operations
+type EpicClient interface {
+	GetEpic(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+	CreateEpic(gid interface{}, opt *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+	UpdateEpic(gid interface{}, epic int, opt *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+	DeleteEpic(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewEpicClient returns a new Gitlab Epic service
+func NewEpicClient(cfg clients.Config) EpicClient {
+	git := clients.NewClient(cfg)
+	return git.Epics
+}
+
+// GenerateCreateEpicOptions generates epic creation options
+func GenerateCreateEpicOptions(p *v1alpha1.EpicParameters) *gitlab.CreateEpicOptions {
+	opt := &gitlab.CreateEpicOptions{
+		Title:        &p.Title,
+		Description:  p.Description,
+		Confidential: p.Confidential,
+		ParentID:     p.ParentID,
+	}
+
+	if p.Labels != nil {
+		opt.Labels = (*gitlab.LabelOptions)(&p.Labels)
+	}
+	if p.StartDate != nil {
+		opt.StartDateIsFixed = gitlab.Ptr(true)
+		opt.StartDateFixed = (*gitlab.ISOTime)(&p.StartDate.Time)
+	}
+	if p.DueDate != nil {
+		opt.DueDateIsFixed = gitlab.Ptr(true)
+		opt.DueDateFixed = (*gitlab.ISOTime)(&p.DueDate.Time)
+	}
+
+	return opt
+}
+
+// GenerateUpdateEpicOptions generates epic update options
+func GenerateUpdateEpicOptions(p *v1alpha1.EpicParameters) *gitlab.UpdateEpicOptions {
+	opt := &gitlab.UpdateEpicOptions{
+		Title:        &p.Title,
+		Description:  p.Description,
+		Confidential: p.Confidential,
+		ParentID:     p.ParentID,
+	}
+
+	if p.Labels != nil {
+		opt.Labels = (*gitlab.LabelOptions)(&p.Labels)
+	}
+	if p.StartDate != nil {
+		opt.StartDateIsFixed = gitlab.Ptr(true)
+		opt.StartDateFixed = (*gitlab.ISOTime)(&p.StartDate.Time)
+	}
+	if p.DueDate != nil {
+		opt.DueDateIsFixed = gitlab.Ptr(true)
+		opt.DueDateFixed = (*gitlab.ISOTime)(&p.DueDate.Time)
+	}
+
+	return opt
+}
+
+// GenerateEpicObservation is used to produce v1alpha1.EpicObservation from
+// gitlab.Epic.
+func GenerateEpicObservation(e *gitlab.Epic) v1alpha1.EpicObservation {
+	if e == nil {
+		return v1alpha1.EpicObservation{}
+	}
+
+	return v1alpha1.EpicObservation{
+		ID:        e.ID,
+		IID:       e.IID,
+		State:     e.State,
+		WebURL:    e.WebURL,
+		CreatedAt: clients.TimeToMetaTime(e.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(e.UpdatedAt),
+		ClosedAt:  clients.TimeToMetaTime(e.ClosedAt),
+	}
+}
+
+// IsEpicDateEqual returns true if the fixed date of an epic is the same day
+// as the desired date. GitLab only stores the date part.
+func IsEpicDateEqual(want *time.Time, isFixed bool, got *gitlab.ISOTime) bool {
+	if want == nil {
+		return true
+	}
+	if !isFixed || got == nil {
+		return false
+	}
+	return want.UTC().Format(time.DateOnly) == got.String()
+}
@@ -34,6 +34,9 @@ type MockClient struct {
 	MockDeleteGroup           func(pid interface{}, opt *gitlab.DeleteGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	MockShareGroupWithGroup   func(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	MockUnshareGroupFromGroup func(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockSyncGroupLDAP         func(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockGetGroupSettings      func(gid interface{}, options ...gitlab.RequestOptionFunc) (*groups.GroupSettings, *gitlab.Response, error)
+	MockUpdateGroupSettings   func(gid interface{}, opt *groups.GroupSettingsOptions, options ...gitlab.RequestOptionFunc) (*groups.GroupSettings, *gitlab.Response, error)
 
 	MockGetMember    func(gid interface{}, user int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
 	MockAddMember    func(gid interface{}, opt *gitlab.AddGroupMemberOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupMember, *gitlab.Response, error)
@@ -46,8 +49,14 @@ type MockClient struct {
 
 	MockGetGroupAccessToken    func(gid interface{}, accessToken int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
 	MockCreateGroupAccessToken func(gid interface{}, opt *gitlab.CreateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
+	MockRotateGroupAccessToken func(gid interface{}, accessToken int, opt *gitlab.RotateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error)
 	MockRevokeGroupAccessToken func(gid interface{}, accessToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
+	MockGetServiceAccountPersonalAccessToken    func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	MockCreateServiceAccountPersonalAccessToken func(gid interface{}, serviceAccount int, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	MockRotateServiceAccountPersonalAccessToken func(gid interface{}, serviceAccount, token int, opt *groups.RotateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	MockRevokeServiceAccountPersonalAccessToken func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
 	MockGetGroupSAMLLink    func(pid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error)
 	MockAddGroupSAMLLink    func(pid interface{}, opt *gitlab.AddGroupSAMLLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error)
 	MockDeleteGroupSAMLLink func(pid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
@@ -58,6 +67,16 @@ type MockClient struct {
 	MockUpdateGroupVariable func(gid interface{}, key string, opt *gitlab.UpdateGroupVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error)
 	MockRemoveGroupVariable func(gid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
+	MockGetEpic    func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+	MockCreateEpic func(gid interface{}, opt *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+	MockUpdateEpic func(gid interface{}, epic int, opt *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error)
+	MockDeleteEpic func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetGroupWikiPage    func(gid interface{}, slug string, opt *gitlab.GetGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error)
+	MockCreateGroupWikiPage func(gid interface{}, opt *gitlab.CreateGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error)
+	MockEditGroupWikiPage   func(gid interface{}, slug string, opt *gitlab.EditGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error)
+	MockDeleteGroupWikiPage func(gid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
 	MockListUsers func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
 }
 
@@ -136,6 +155,11 @@ func (c *MockClient) CreateGroupAccessToken(gid interface{}, opt *gitlab.CreateG
 	return c.MockCreateGroupAccessToken(gid, opt)
 }
 
+// RotateGroupAccessToken calls the underlying MockRotateGroupAccessToken method.
+func (c *MockClient) RotateGroupAccessToken(gid interface{}, accessToken int, opt *gitlab.RotateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error) {
+	return c.MockRotateGroupAccessToken(gid, accessToken, opt)
+}
+
 // RevokeGroupAccessToken calls the underlying MockDeleteGroupDeployToken method.
 func (c *MockClient) RevokeGroupAccessToken(gid interface{}, deployToken int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 	return c.MockRevokeGroupAccessToken(gid, deployToken)
@@ -185,3 +209,82 @@ func (c *MockClient) AddGroupSAMLLink(pid interface{}, opt *gitlab.AddGroupSAMLL
 func (c *MockClient) DeleteGroupSAMLLink(pid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 	return c.MockDeleteGroupSAMLLink(pid, samlGroupName)
 }
+
+// GetEpic calls the underlying MockGetEpic method.
+func (c *MockClient) GetEpic(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+	return c.MockGetEpic(gid, epic)
+}
+
+// CreateEpic calls the underlying MockCreateEpic method.
+func (c *MockClient) CreateEpic(gid interface{}, opt *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+	return c.MockCreateEpic(gid, opt)
+}
+
+// UpdateEpic calls the underlying MockUpdateEpic method.
+func (c *MockClient) UpdateEpic(gid interface{}, epic int, opt *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+	return c.MockUpdateEpic(gid, epic, opt)
+}
+
+// DeleteEpic calls the underlying MockDeleteEpic method.
+func (c *MockClient) DeleteEpic(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteEpic(gid, epic)
+}
+
+// GetGroupWikiPage calls the underlying MockGetGroupWikiPage method.
+func (c *MockClient) GetGroupWikiPage(gid interface{}, slug string, opt *gitlab.GetGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error) {
+	return c.MockGetGroupWikiPage(gid, slug, opt)
+}
+
+// CreateGroupWikiPage calls the underlying MockCreateGroupWikiPage method.
+func (c *MockClient) CreateGroupWikiPage(gid interface{}, opt *gitlab.CreateGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error) {
+	return c.MockCreateGroupWikiPage(gid, opt)
+}
+
+// EditGroupWikiPage calls the underlying MockEditGroupWikiPage method.
+func (c *MockClient) EditGroupWikiPage(gid interface{}, slug string, opt *gitlab.EditGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error) {
+	return c.MockEditGroupWikiPage(gid, slug, opt)
+}
+
+// DeleteGroupWikiPage calls the underlying MockDeleteGroupWikiPage method.
+func (c *MockClient) DeleteGroupWikiPage(gid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteGroupWikiPage(gid, slug)
+}
+
+// SyncGroupLDAP calls the underlying MockSyncGroupLDAP method.
+func (c *MockClient) SyncGroupLDAP(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockSyncGroupLDAP(gid)
+}
+
+// GetServiceAccountPersonalAccessToken calls the underlying
+// MockGetServiceAccountPersonalAccessToken method.
+func (c *MockClient) GetServiceAccountPersonalAccessToken(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return c.MockGetServiceAccountPersonalAccessToken(token)
+}
+
+// CreateServiceAccountPersonalAccessToken calls the underlying
+// MockCreateServiceAccountPersonalAccessToken method.
+func (c *MockClient) CreateServiceAccountPersonalAccessToken(gid interface{}, serviceAccount int, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return c.MockCreateServiceAccountPersonalAccessToken(gid, serviceAccount, opt)
+}
+
+// RotateServiceAccountPersonalAccessToken calls the underlying
+// MockRotateServiceAccountPersonalAccessToken method.
+func (c *MockClient) RotateServiceAccountPersonalAccessToken(gid interface{}, serviceAccount, token int, opt *groups.RotateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return c.MockRotateServiceAccountPersonalAccessToken(gid, serviceAccount, token, opt)
+}
+
+// RevokeServiceAccountPersonalAccessToken calls the underlying
+// MockRevokeServiceAccountPersonalAccessToken method.
+func (c *MockClient) RevokeServiceAccountPersonalAccessToken(token int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockRevokeServiceAccountPersonalAccessToken(token)
+}
+
+// GetGroupSettings calls the underlying MockGetGroupSettings method.
+func (c *MockClient) GetGroupSettings(gid interface{}, options ...gitlab.RequestOptionFunc) (*groups.GroupSettings, *gitlab.Response, error) {
+	return c.MockGetGroupSettings(gid)
+}
+
+// UpdateGroupSettings calls the underlying MockUpdateGroupSettings method.
+func (c *MockClient) UpdateGroupSettings(gid interface{}, opt *groups.GroupSettingsOptions, options ...gitlab.RequestOptionFunc) (*groups.GroupSettings, *gitlab.Response, error) {
+	return c.MockUpdateGroupSettings(gid, opt)
+}
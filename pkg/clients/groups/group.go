@@ -39,12 +39,25 @@ type Client interface {
 	DeleteGroup(gid interface{}, opt *gitlab.DeleteGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 	ShareGroupWithGroup(gid interface{}, opt *gitlab.ShareGroupWithGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
 	UnshareGroupFromGroup(gid interface{}, groupID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	LDAPSyncClient
+	GroupSettingsClient
+}
+
+type groupClient struct {
+	*gitlab.GroupsService
+	*ldapSyncService
+	*groupSettingsService
 }
 
 // NewGroupClient returns a new Gitlab Group service
 func NewGroupClient(cfg clients.Config) Client {
 	git := clients.NewClient(cfg)
-	return git.Groups
+	return &groupClient{
+		GroupsService:        git.Groups,
+		ldapSyncService:      &ldapSyncService{client: git},
+		groupSettingsService: &groupSettingsService{client: git},
+	}
 }
 
 // IsErrorGroupNotFound helper function to test for errGroupNotFound error.
@@ -146,24 +159,27 @@ func GenerateCreateGroupOptions(name string, p *v1alpha1.GroupParameters) *gitla
 	}
 
 	group := &gitlab.CreateGroupOptions{
-		Name:                           &name,
-		Path:                           &p.Path,
-		Description:                    p.Description,
-		MembershipLock:                 p.MembershipLock,
-		Visibility:                     VisibilityValueV1alpha1ToGitlab(p.Visibility),
-		ShareWithGroupLock:             p.ShareWithGroupLock,
-		RequireTwoFactorAuth:           p.RequireTwoFactorAuth,
-		TwoFactorGracePeriod:           p.TwoFactorGracePeriod,
-		ProjectCreationLevel:           ProjectCreationLevelValueV1alpha1ToGitlab(p.ProjectCreationLevel),
-		AutoDevopsEnabled:              p.AutoDevopsEnabled,
-		SubGroupCreationLevel:          SubGroupCreationLevelValueV1alpha1ToGitlab(p.SubGroupCreationLevel),
-		MentionsDisabled:               p.MentionsDisabled,
-		EmailsEnabled:                  p.EmailsEnabled,
-		LFSEnabled:                     p.LFSEnabled,
-		RequestAccessEnabled:           p.RequestAccessEnabled,
-		ParentID:                       p.ParentID,
-		SharedRunnersMinutesLimit:      p.SharedRunnersMinutesLimit,
-		ExtraSharedRunnersMinutesLimit: p.ExtraSharedRunnersMinutesLimit,
+		Name:                            &name,
+		Path:                            &p.Path,
+		Description:                     p.Description,
+		MembershipLock:                  p.MembershipLock,
+		Visibility:                      VisibilityValueV1alpha1ToGitlab(p.Visibility),
+		ShareWithGroupLock:              p.ShareWithGroupLock,
+		RequireTwoFactorAuth:            p.RequireTwoFactorAuth,
+		TwoFactorGracePeriod:            p.TwoFactorGracePeriod,
+		ProjectCreationLevel:            ProjectCreationLevelValueV1alpha1ToGitlab(p.ProjectCreationLevel),
+		AutoDevopsEnabled:               p.AutoDevopsEnabled,
+		SubGroupCreationLevel:           SubGroupCreationLevelValueV1alpha1ToGitlab(p.SubGroupCreationLevel),
+		MentionsDisabled:                p.MentionsDisabled,
+		EmailsEnabled:                   p.EmailsEnabled,
+		LFSEnabled:                      p.LFSEnabled,
+		RequestAccessEnabled:            p.RequestAccessEnabled,
+		ParentID:                        p.ParentID,
+		SharedRunnersMinutesLimit:       p.SharedRunnersMinutesLimit,
+		ExtraSharedRunnersMinutesLimit:  p.ExtraSharedRunnersMinutesLimit,
+		DefaultBranchProtection:         p.DefaultBranchProtection,
+		EmailsDisabled:                  p.EmailsDisabled, //nolint:staticcheck // only set for GitLab versions without emails_enabled
+		DefaultBranchProtectionDefaults: GenerateDefaultBranchProtectionDefaultsOptions(p.DefaultBranchProtectionDefaults),
 	}
 
 	return group
@@ -177,23 +193,59 @@ func GenerateEditGroupOptions(name string, p *v1alpha1.GroupParameters) *gitlab.
 	}
 
 	group := &gitlab.UpdateGroupOptions{
-		Name:                           &name,
-		Path:                           &p.Path,
-		Description:                    p.Description,
-		MembershipLock:                 p.MembershipLock,
-		Visibility:                     VisibilityValueV1alpha1ToGitlab(p.Visibility),
-		ShareWithGroupLock:             p.ShareWithGroupLock,
-		RequireTwoFactorAuth:           p.RequireTwoFactorAuth,
-		TwoFactorGracePeriod:           p.TwoFactorGracePeriod,
-		ProjectCreationLevel:           ProjectCreationLevelValueV1alpha1ToGitlab(p.ProjectCreationLevel),
-		AutoDevopsEnabled:              p.AutoDevopsEnabled,
-		SubGroupCreationLevel:          SubGroupCreationLevelValueV1alpha1ToGitlab(p.SubGroupCreationLevel),
-		EmailsEnabled:                  p.EmailsEnabled,
-		MentionsDisabled:               p.MentionsDisabled,
-		LFSEnabled:                     p.LFSEnabled,
-		RequestAccessEnabled:           p.RequestAccessEnabled,
-		SharedRunnersMinutesLimit:      p.SharedRunnersMinutesLimit,
-		ExtraSharedRunnersMinutesLimit: p.ExtraSharedRunnersMinutesLimit,
+		Name:                                 &name,
+		Path:                                 &p.Path,
+		Description:                          p.Description,
+		MembershipLock:                       p.MembershipLock,
+		Visibility:                           VisibilityValueV1alpha1ToGitlab(p.Visibility),
+		ShareWithGroupLock:                   p.ShareWithGroupLock,
+		PreventForkingOutsideGroup:           p.PreventForkingOutsideGroup,
+		PreventSharingGroupsOutsideHierarchy: p.PreventSharingGroupsOutsideHierarchy,
+		RequireTwoFactorAuth:                 p.RequireTwoFactorAuth,
+		TwoFactorGracePeriod:                 p.TwoFactorGracePeriod,
+		ProjectCreationLevel:                 ProjectCreationLevelValueV1alpha1ToGitlab(p.ProjectCreationLevel),
+		AutoDevopsEnabled:                    p.AutoDevopsEnabled,
+		SubGroupCreationLevel:                SubGroupCreationLevelValueV1alpha1ToGitlab(p.SubGroupCreationLevel),
+		EmailsEnabled:                        p.EmailsEnabled,
+		MentionsDisabled:                     p.MentionsDisabled,
+		LFSEnabled:                           p.LFSEnabled,
+		RequestAccessEnabled:                 p.RequestAccessEnabled,
+		SharedRunnersMinutesLimit:            p.SharedRunnersMinutesLimit,
+		ExtraSharedRunnersMinutesLimit:       p.ExtraSharedRunnersMinutesLimit,
+		DefaultBranchProtection:              p.DefaultBranchProtection,
+		EmailsDisabled:                       p.EmailsDisabled, //nolint:staticcheck // only set for GitLab versions without emails_enabled
+		DefaultBranchProtectionDefaults:      GenerateDefaultBranchProtectionDefaultsOptions(p.DefaultBranchProtectionDefaults),
 	}
 	return group
 }
+
+// GenerateDefaultBranchProtectionDefaultsOptions generates the nested
+// default_branch_protection_defaults options of a group.
+func GenerateDefaultBranchProtectionDefaultsOptions(p *v1alpha1.DefaultBranchProtectionDefaults) *gitlab.DefaultBranchProtectionDefaultsOptions {
+	if p == nil {
+		return nil
+	}
+
+	opts := &gitlab.DefaultBranchProtectionDefaultsOptions{
+		AllowForcePush:          p.AllowForcePush,
+		DeveloperCanInitialPush: p.DeveloperCanInitialPush,
+	}
+
+	if p.AllowedToPush != nil {
+		opts.AllowedToPush = accessLevelsV1alpha1ToGitlab(p.AllowedToPush)
+	}
+
+	if p.AllowedToMerge != nil {
+		opts.AllowedToMerge = accessLevelsV1alpha1ToGitlab(p.AllowedToMerge)
+	}
+
+	return opts
+}
+
+func accessLevelsV1alpha1ToGitlab(from []v1alpha1.AccessLevelValue) *[]*gitlab.GroupAccessLevel {
+	levels := make([]*gitlab.GroupAccessLevel, len(from))
+	for i := range from {
+		levels[i] = &gitlab.GroupAccessLevel{AccessLevel: (*gitlab.AccessLevelValue)(&from[i])}
+	}
+	return &levels
+}
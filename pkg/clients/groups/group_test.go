@@ -393,3 +393,47 @@ func TestGenerateEditGroupOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateDefaultBranchProtectionDefaultsOptions(t *testing.T) {
+	maintainer := v1alpha1.MaintainerPermissions
+	developer := v1alpha1.DeveloperPermissions
+	allowForcePush := false
+
+	cases := map[string]struct {
+		parameters *v1alpha1.DefaultBranchProtectionDefaults
+		want       *gitlab.DefaultBranchProtectionDefaultsOptions
+	}{
+		"Nil": {
+			parameters: nil,
+			want:       nil,
+		},
+		"AllFields": {
+			parameters: &v1alpha1.DefaultBranchProtectionDefaults{
+				AllowedToPush:           []v1alpha1.AccessLevelValue{maintainer},
+				AllowForcePush:          &allowForcePush,
+				AllowedToMerge:          []v1alpha1.AccessLevelValue{developer, maintainer},
+				DeveloperCanInitialPush: &allowForcePush,
+			},
+			want: &gitlab.DefaultBranchProtectionDefaultsOptions{
+				AllowedToPush: &[]*gitlab.GroupAccessLevel{
+					{AccessLevel: gitlab.Ptr(gitlab.MaintainerPermissions)},
+				},
+				AllowForcePush: &allowForcePush,
+				AllowedToMerge: &[]*gitlab.GroupAccessLevel{
+					{AccessLevel: gitlab.Ptr(gitlab.DeveloperPermissions)},
+					{AccessLevel: gitlab.Ptr(gitlab.MaintainerPermissions)},
+				},
+				DeveloperCanInitialPush: &allowForcePush,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDefaultBranchProtectionDefaultsOptions(tc.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
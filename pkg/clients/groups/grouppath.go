@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	errResolveGroupPath = "cannot resolve Gitlab group path"
+)
+
+// GroupGetter gets a Gitlab group by ID or URL-encoded path.
+type GroupGetter interface {
+	GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error)
+}
+
+// groupCache is shared by the group getters of all controllers.
+var groupCache = clients.NewLookupCache[*gitlab.Group](clients.DefaultLookupCacheTTL)
+
+// NewGroupGetter returns a new Gitlab group getter. Groups are cached for
+// a short time, as many resources of a group look it up on every reconcile.
+func NewGroupGetter(cfg clients.Config) GroupGetter {
+	git := clients.NewClient(cfg)
+	return &cachedGroupGetter{getter: git.Groups, cache: groupCache, cfg: cfg}
+}
+
+type cachedGroupGetter struct {
+	getter GroupGetter
+	cache  *clients.LookupCache[*gitlab.Group]
+	cfg    clients.Config
+}
+
+// GetGroup gets a group, from the cache if it was recently looked up.
+// Requests with options are never cached.
+func (c *cachedGroupGetter) GetGroup(id interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	if opt != nil {
+		return c.getter.GetGroup(id, opt, options...)
+	}
+	var res *gitlab.Response
+	grp, err := c.cache.Get(clients.LookupCacheKey(c.cfg, "group", fmt.Sprint(id)), func() (*gitlab.Group, error) {
+		grp, r, err := c.getter.GetGroup(id, nil, options...)
+		res = r
+		return grp, err
+	})
+	return grp, res, err
+}
+
+// ResolveGroupPath returns the ID of the group with the given full path,
+// e.g. my-group/my-subgroup. It allows group scoped resources to reference
+// groups that are not managed by Crossplane.
+func ResolveGroupPath(ctx context.Context, c GroupGetter, path string) (int, error) {
+	grp, _, err := c.GetGroup(path, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, errResolveGroupPath)
+	}
+	return grp.ID, nil
+}
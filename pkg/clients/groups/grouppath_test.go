@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+)
+
+type mockGroupGetter func(gid interface{}) (*gitlab.Group, *gitlab.Response, error)
+
+func (m mockGroupGetter) GetGroup(gid interface{}, opt *gitlab.GetGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+	return m(gid)
+}
+
+func TestResolveGroupPath(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		id  int
+		err error
+	}
+	cases := map[string]struct {
+		getter mockGroupGetter
+		path   string
+		want   want
+	}{
+		"Resolved": {
+			getter: func(gid interface{}) (*gitlab.Group, *gitlab.Response, error) {
+				if gid != "my-group/my-subgroup" {
+					return nil, &gitlab.Response{}, errBoom
+				}
+				return &gitlab.Group{ID: 42}, &gitlab.Response{}, nil
+			},
+			path: "my-group/my-subgroup",
+			want: want{id: 42},
+		},
+		"Failed": {
+			getter: func(gid interface{}) (*gitlab.Group, *gitlab.Response, error) {
+				return nil, &gitlab.Response{}, errBoom
+			},
+			path: "my-group/my-subgroup",
+			want: want{err: errors.Wrap(errBoom, errResolveGroupPath)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := ResolveGroupPath(context.Background(), tc.getter, tc.path)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+// GroupSettings holds the group settings that the go-gitlab client does not
+// decode from the group API yet.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#update-group-attributes
+type GroupSettings struct {
+	PreventSharingGroupsOutsideHierarchy        bool `json:"prevent_sharing_groups_outside_hierarchy"`
+	UniqueProjectDownloadLimit                  int  `json:"unique_project_download_limit"`
+	UniqueProjectDownloadLimitIntervalInSeconds int  `json:"unique_project_download_limit_interval_in_seconds"`
+}
+
+// GroupSettingsOptions represents the available UpdateGroupSettings()
+// options.
+type GroupSettingsOptions struct {
+	UniqueProjectDownloadLimit                  *int `url:"unique_project_download_limit,omitempty" json:"unique_project_download_limit,omitempty"`
+	UniqueProjectDownloadLimitIntervalInSeconds *int `url:"unique_project_download_limit_interval_in_seconds,omitempty" json:"unique_project_download_limit_interval_in_seconds,omitempty"`
+}
+
+// GroupSettingsClient defines Gitlab group settings operations that the
+// go-gitlab client does not model yet.
+type GroupSettingsClient interface {
+	GetGroupSettings(gid interface{}, options ...gitlab.RequestOptionFunc) (*GroupSettings, *gitlab.Response, error)
+	UpdateGroupSettings(gid interface{}, opt *GroupSettingsOptions, options ...gitlab.RequestOptionFunc) (*GroupSettings, *gitlab.Response, error)
+}
+
+type groupSettingsService struct {
+	client *gitlab.Client
+}
+
+// GetGroupSettings gets the settings of a group that go-gitlab does not
+// decode.
+func (s *groupSettingsService) GetGroupSettings(gid interface{}, options ...gitlab.RequestOptionFunc) (*GroupSettings, *gitlab.Response, error) {
+	return s.do(http.MethodGet, gid, &gitlab.GetGroupOptions{WithProjects: gitlab.Ptr(false)}, options)
+}
+
+// UpdateGroupSettings updates the settings of a group that go-gitlab does
+// not support.
+func (s *groupSettingsService) UpdateGroupSettings(gid interface{}, opt *GroupSettingsOptions, options ...gitlab.RequestOptionFunc) (*GroupSettings, *gitlab.Response, error) {
+	return s.do(http.MethodPut, gid, opt, options)
+}
+
+func (s *groupSettingsService) do(method string, gid interface{}, opt interface{}, options []gitlab.RequestOptionFunc) (*GroupSettings, *gitlab.Response, error) {
+	u := fmt.Sprintf("groups/%s", gitlab.PathEscape(fmt.Sprint(gid)))
+	req, err := s.client.NewRequest(method, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gs := new(GroupSettings)
+	resp, err := s.client.Do(req, gs)
+	if err != nil {
+		return nil, resp, err
+	}
+	return gs, resp, nil
+}
+
+// NeedsGroupSettings returns true if the parameters set any group setting
+// that is only available through GroupSettingsClient.
+func NeedsGroupSettings(p *v1alpha1.GroupParameters) bool {
+	return p.PreventSharingGroupsOutsideHierarchy != nil ||
+		p.UniqueProjectDownloadLimit != nil ||
+		p.UniqueProjectDownloadLimitIntervalInSeconds != nil
+}
+
+// GenerateGroupSettingsOptions generates the options of the group settings
+// go-gitlab does not support, or nil if none of them is set.
+func GenerateGroupSettingsOptions(p *v1alpha1.GroupParameters) *GroupSettingsOptions {
+	if p.UniqueProjectDownloadLimit == nil && p.UniqueProjectDownloadLimitIntervalInSeconds == nil {
+		return nil
+	}
+	return &GroupSettingsOptions{
+		UniqueProjectDownloadLimit:                  p.UniqueProjectDownloadLimit,
+		UniqueProjectDownloadLimitIntervalInSeconds: p.UniqueProjectDownloadLimitIntervalInSeconds,
+	}
+}
+
+// GroupSettingsDiff returns the names of the group settings whose desired
+// value differs from the observed settings.
+func GroupSettingsDiff(p *v1alpha1.GroupParameters, gs *GroupSettings) []string {
+	var diff []string
+	if p.PreventSharingGroupsOutsideHierarchy != nil && *p.PreventSharingGroupsOutsideHierarchy != gs.PreventSharingGroupsOutsideHierarchy {
+		diff = append(diff, "preventSharingGroupsOutsideHierarchy")
+	}
+	if p.UniqueProjectDownloadLimit != nil && *p.UniqueProjectDownloadLimit != gs.UniqueProjectDownloadLimit {
+		diff = append(diff, "uniqueProjectDownloadLimit")
+	}
+	if p.UniqueProjectDownloadLimitIntervalInSeconds != nil && *p.UniqueProjectDownloadLimitIntervalInSeconds != gs.UniqueProjectDownloadLimitIntervalInSeconds {
+		diff = append(diff, "uniqueProjectDownloadLimitIntervalInSeconds")
+	}
+	return diff
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func TestGenerateGroupSettingsOptions(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.GroupParameters
+		want *GroupSettingsOptions
+	}{
+		"NotSet": {
+			p: &v1alpha1.GroupParameters{PreventSharingGroupsOutsideHierarchy: gitlab.Ptr(true)},
+		},
+		"DownloadLimit": {
+			p: &v1alpha1.GroupParameters{
+				UniqueProjectDownloadLimit:                  gitlab.Ptr(10),
+				UniqueProjectDownloadLimitIntervalInSeconds: gitlab.Ptr(3600),
+			},
+			want: &GroupSettingsOptions{
+				UniqueProjectDownloadLimit:                  gitlab.Ptr(10),
+				UniqueProjectDownloadLimitIntervalInSeconds: gitlab.Ptr(3600),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateGroupSettingsOptions(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupSettingsDiff(t *testing.T) {
+	observed := &GroupSettings{
+		PreventSharingGroupsOutsideHierarchy:        true,
+		UniqueProjectDownloadLimit:                  10,
+		UniqueProjectDownloadLimitIntervalInSeconds: 3600,
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.GroupParameters
+		want []string
+	}{
+		"NotSet": {
+			p: &v1alpha1.GroupParameters{},
+		},
+		"UpToDate": {
+			p: &v1alpha1.GroupParameters{
+				PreventSharingGroupsOutsideHierarchy:        gitlab.Ptr(true),
+				UniqueProjectDownloadLimit:                  gitlab.Ptr(10),
+				UniqueProjectDownloadLimitIntervalInSeconds: gitlab.Ptr(3600),
+			},
+		},
+		"Differ": {
+			p: &v1alpha1.GroupParameters{
+				PreventSharingGroupsOutsideHierarchy:        gitlab.Ptr(false),
+				UniqueProjectDownloadLimit:                  gitlab.Ptr(0),
+				UniqueProjectDownloadLimitIntervalInSeconds: gitlab.Ptr(60),
+			},
+			want: []string{"preventSharingGroupsOutsideHierarchy", "uniqueProjectDownloadLimit", "uniqueProjectDownloadLimitIntervalInSeconds"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GroupSettingsDiff(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
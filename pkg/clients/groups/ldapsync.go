@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// LDAPSyncClient defines Gitlab group LDAP sync operations.
+// The go-gitlab client does not model this API yet.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#sync-group-with-ldap
+type LDAPSyncClient interface {
+	SyncGroupLDAP(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type ldapSyncService struct {
+	client *gitlab.Client
+}
+
+// SyncGroupLDAP queues a sync of the group with its linked LDAP groups. The
+// sync itself runs asynchronously in GitLab.
+func (s *ldapSyncService) SyncGroupLDAP(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	u := fmt.Sprintf("groups/%s/ldap_sync", gitlab.PathEscape(fmt.Sprint(gid)))
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(req, nil)
+}
@@ -55,6 +55,7 @@ func GenerateAddSamlGroupLinkOptions(p *v1alpha1.SamlGroupLinkParameters) *gitla
 	samlGroupName := &gitlab.AddGroupSAMLLinkOptions{
 		SAMLGroupName: p.Name,
 		AccessLevel:   (*gitlab.AccessLevelValue)(&p.AccessLevel),
+		MemberRoleID:  p.MemberRoleID,
 	}
 
 	return samlGroupName
@@ -67,7 +68,8 @@ func GenerateAddSamlGroupLinkObservation(samlGroupLink *gitlab.SAMLGroupLink) v1
 	}
 
 	output := v1alpha1.SamlGroupLinkObservation{
-		Name: samlGroupLink.Name,
+		Name:         samlGroupLink.Name,
+		MemberRoleID: samlGroupLink.MemberRoleID,
 	}
 
 	return output
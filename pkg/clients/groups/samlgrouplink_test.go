@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func TestGenerateAddSamlGroupLinkOptions(t *testing.T) {
+	name := "saml-group"
+	memberRoleID := 7
+	accessLevel := gitlab.DeveloperPermissions
+
+	cases := map[string]struct {
+		parameters *v1alpha1.SamlGroupLinkParameters
+		want       *gitlab.AddGroupSAMLLinkOptions
+	}{
+		"WithMemberRole": {
+			parameters: &v1alpha1.SamlGroupLinkParameters{
+				Name:         &name,
+				AccessLevel:  v1alpha1.AccessLevelValue(accessLevel),
+				MemberRoleID: &memberRoleID,
+			},
+			want: &gitlab.AddGroupSAMLLinkOptions{
+				SAMLGroupName: &name,
+				AccessLevel:   &accessLevel,
+				MemberRoleID:  &memberRoleID,
+			},
+		},
+		"WithoutMemberRole": {
+			parameters: &v1alpha1.SamlGroupLinkParameters{
+				Name:        &name,
+				AccessLevel: v1alpha1.AccessLevelValue(accessLevel),
+			},
+			want: &gitlab.AddGroupSAMLLinkOptions{
+				SAMLGroupName: &name,
+				AccessLevel:   &accessLevel,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAddSamlGroupLinkOptions(tc.parameters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAddSamlGroupLinkObservation(t *testing.T) {
+	cases := map[string]struct {
+		link *gitlab.SAMLGroupLink
+		want v1alpha1.SamlGroupLinkObservation
+	}{
+		"Nil": {
+			want: v1alpha1.SamlGroupLinkObservation{},
+		},
+		"WithMemberRole": {
+			link: &gitlab.SAMLGroupLink{Name: "saml-group", MemberRoleID: 7},
+			want: v1alpha1.SamlGroupLinkObservation{Name: "saml-group", MemberRoleID: 7},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAddSamlGroupLinkObservation(tc.link)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
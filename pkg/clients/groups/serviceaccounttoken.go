@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// RotateServiceAccountPersonalAccessTokenOptions represents the available
+// RotateServiceAccountPersonalAccessToken() options. The go-gitlab client
+// does not support setting the expiration date of the rotated token yet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/groups.html#rotate-a-personal-access-token-for-service-account-user
+type RotateServiceAccountPersonalAccessTokenOptions struct {
+	ExpiresAt *gitlab.ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// ServiceAccountTokenClient defines Gitlab group service account token
+// operations
+type ServiceAccountTokenClient interface {
+	GetServiceAccountPersonalAccessToken(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	CreateServiceAccountPersonalAccessToken(gid interface{}, serviceAccount int, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	RotateServiceAccountPersonalAccessToken(gid interface{}, serviceAccount, token int, opt *RotateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error)
+	RevokeServiceAccountPersonalAccessToken(token int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type serviceAccountTokenService struct {
+	client *gitlab.Client
+}
+
+// NewServiceAccountTokenClient returns a new Gitlab group service account
+// token service
+func NewServiceAccountTokenClient(cfg clients.Config) ServiceAccountTokenClient {
+	git := clients.NewClient(cfg)
+	return &serviceAccountTokenService{client: git}
+}
+
+// GetServiceAccountPersonalAccessToken gets a single personal access token by
+// its ID.
+func (s *serviceAccountTokenService) GetServiceAccountPersonalAccessToken(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return s.client.PersonalAccessTokens.GetSinglePersonalAccessTokenByID(token, options...)
+}
+
+// CreateServiceAccountPersonalAccessToken creates a personal access token for
+// a group service account.
+func (s *serviceAccountTokenService) CreateServiceAccountPersonalAccessToken(gid interface{}, serviceAccount int, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	return s.client.Groups.CreateServiceAccountPersonalAccessToken(gid, serviceAccount, opt, options...)
+}
+
+// RotateServiceAccountPersonalAccessToken revokes a personal access token of
+// a group service account and returns a new one.
+func (s *serviceAccountTokenService) RotateServiceAccountPersonalAccessToken(gid interface{}, serviceAccount, token int, opt *RotateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+	u := fmt.Sprintf("groups/%s/service_accounts/%d/personal_access_tokens/%d/rotate", gitlab.PathEscape(fmt.Sprint(gid)), serviceAccount, token)
+	req, err := s.client.NewRequest(http.MethodPost, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(gitlab.PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+	return pat, resp, nil
+}
+
+// RevokeServiceAccountPersonalAccessToken revokes a personal access token by
+// its ID.
+func (s *serviceAccountTokenService) RevokeServiceAccountPersonalAccessToken(token int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return s.client.PersonalAccessTokens.RevokePersonalAccessTokenByID(token, options...)
+}
+
+// GenerateCreateServiceAccountTokenOptions generates service account token
+// creation options
+func GenerateCreateServiceAccountTokenOptions(p *v1alpha1.ServiceAccountTokenParameters) *gitlab.CreateServiceAccountPersonalAccessTokenOptions {
+	opts := &gitlab.CreateServiceAccountPersonalAccessTokenOptions{
+		Name:   &p.Name,
+		Scopes: &p.Scopes,
+	}
+
+	if p.ExpiresAt != nil {
+		opts.ExpiresAt = (*gitlab.ISOTime)(&p.ExpiresAt.Time)
+	} else if p.ExpiresAtPolicy != nil {
+		opts.ExpiresAt = expiresAtFromPolicy(p.ExpiresAtPolicy, time.Now())
+	}
+
+	return opts
+}
+
+// GenerateRotateServiceAccountTokenOptions generates service account token
+// rotation options from the expiresAt policy.
+func GenerateRotateServiceAccountTokenOptions(p *v1alpha1.ServiceAccountTokenParameters, now time.Time) *RotateServiceAccountPersonalAccessTokenOptions {
+	opts := &RotateServiceAccountPersonalAccessTokenOptions{}
+	if p.ExpiresAtPolicy != nil {
+		opts.ExpiresAt = expiresAtFromPolicy(p.ExpiresAtPolicy, now)
+	}
+	return opts
+}
+
+// GenerateServiceAccountTokenObservation is used to produce
+// v1alpha1.ServiceAccountTokenObservation from gitlab.PersonalAccessToken.
+func GenerateServiceAccountTokenObservation(pat *gitlab.PersonalAccessToken) v1alpha1.ServiceAccountTokenObservation {
+	if pat == nil {
+		return v1alpha1.ServiceAccountTokenObservation{}
+	}
+
+	o := v1alpha1.ServiceAccountTokenObservation{
+		TokenID:    gitlab.Ptr(pat.ID),
+		UserID:     gitlab.Ptr(pat.UserID),
+		LastUsedAt: clients.TimeToMetaTime(pat.LastUsedAt),
+		Active:     pat.Active,
+		Revoked:    pat.Revoked,
+	}
+	if pat.ExpiresAt != nil {
+		o.ExpiresAt = &metav1.Time{Time: time.Time(*pat.ExpiresAt)}
+	}
+	return o
+}
+
+// IsServiceAccountTokenExpiring returns true if the expiresAt policy requires
+// the token to be rotated at the given time.
+func IsServiceAccountTokenExpiring(p *v1alpha1.ServiceAccountTokenParameters, pat *gitlab.PersonalAccessToken, now time.Time) bool {
+	if p.ExpiresAtPolicy == nil || pat == nil || pat.ExpiresAt == nil {
+		return false
+	}
+	rotateAt := time.Time(*pat.ExpiresAt).AddDate(0, 0, -p.ExpiresAtPolicy.RotateDaysBefore)
+	return !now.Before(rotateAt)
+}
@@ -22,6 +22,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
@@ -97,6 +98,23 @@ func VariableToParameters(in gitlab.GroupVariable) v1alpha1.VariableParameters {
 	}
 }
 
+// GenerateVariableObservation is used to produce v1alpha1.VariableObservation
+// from gitlab.GroupVariable.
+func GenerateVariableObservation(variable *gitlab.GroupVariable) v1alpha1.VariableObservation {
+	if variable == nil {
+		return v1alpha1.VariableObservation{}
+	}
+
+	return v1alpha1.VariableObservation{
+		VariableType:     v1alpha1.VariableType(variable.VariableType),
+		Protected:        variable.Protected,
+		Masked:           variable.Masked,
+		Raw:              variable.Raw,
+		EnvironmentScope: variable.EnvironmentScope,
+		ValueChecksum:    clients.ValueChecksum(variable.Value),
+	}
+}
+
 // GenerateCreateVariableOptions generates group creation options
 func GenerateCreateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.CreateGroupVariableOptions {
 	variable := &gitlab.CreateGroupVariableOptions{
@@ -135,6 +153,22 @@ func GenerateVariableFilter(p *v1alpha1.VariableParameters) *gitlab.VariableFilt
 	}
 }
 
+// WithVariableFilter restricts a request for a single group variable to the
+// parameters' environment scope. The group variables API of the GitLab client
+// has no options to pass the filter, so it is added to the query directly.
+func WithVariableFilter(p *v1alpha1.VariableParameters) gitlab.RequestOptionFunc {
+	return func(r *retryablehttp.Request) error {
+		f := GenerateVariableFilter(p)
+		if f == nil {
+			return nil
+		}
+		q := r.URL.Query()
+		q.Set("filter[environment_scope]", f.EnvironmentScope)
+		r.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
 // IsVariableUpToDate checks whether there is a change in any of the modifiable fields.
 func IsVariableUpToDate(p *v1alpha1.VariableParameters, g *gitlab.GroupVariable) bool {
 	if p == nil {
@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-retryablehttp"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+)
+
+func TestWithVariableFilter(t *testing.T) {
+	production := "production"
+	cases := map[string]struct {
+		p    *v1alpha1.VariableParameters
+		want string
+	}{
+		"NoScope": {
+			p:    &v1alpha1.VariableParameters{Key: "KEY"},
+			want: "",
+		},
+		"Scope": {
+			p:    &v1alpha1.VariableParameters{Key: "KEY", EnvironmentScope: &production},
+			want: "filter%5Benvironment_scope%5D=production",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req, err := retryablehttp.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/groups/1/variables/KEY", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := WithVariableFilter(tc.p)(req); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, req.URL.RawQuery); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// WikiPageClient defines Gitlab group wiki service operations
+type WikiPageClient interface {
+	GetGroupWikiPage(gid interface{}, slug string, opt *gitlab.GetGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error)
+	CreateGroupWikiPage(gid interface{}, opt *gitlab.CreateGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error)
+	EditGroupWikiPage(gid interface{}, slug string, opt *gitlab.EditGroupWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupWiki, *gitlab.Response, error)
+	DeleteGroupWikiPage(gid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewWikiPageClient returns a new Gitlab group wiki service
+func NewWikiPageClient(cfg clients.Config) WikiPageClient {
+	git := clients.NewClient(cfg)
+	return git.GroupWikis
+}
+
+// GenerateCreateWikiPageOptions generates group wiki page creation options
+func GenerateCreateWikiPageOptions(p *v1alpha1.WikiPageParameters) *gitlab.CreateGroupWikiPageOptions {
+	return &gitlab.CreateGroupWikiPageOptions{
+		Title:   &p.Title,
+		Content: &p.Content,
+		Format:  (*gitlab.WikiFormatValue)(p.Format),
+	}
+}
+
+// GenerateEditWikiPageOptions generates group wiki page edit options
+func GenerateEditWikiPageOptions(p *v1alpha1.WikiPageParameters) *gitlab.EditGroupWikiPageOptions {
+	return &gitlab.EditGroupWikiPageOptions{
+		Title:   &p.Title,
+		Content: &p.Content,
+		Format:  (*gitlab.WikiFormatValue)(p.Format),
+	}
+}
+
+// GenerateWikiPageObservation is used to produce v1alpha1.WikiPageObservation
+// from gitlab.GroupWiki.
+func GenerateWikiPageObservation(w *gitlab.GroupWiki) v1alpha1.WikiPageObservation {
+	if w == nil {
+		return v1alpha1.WikiPageObservation{}
+	}
+
+	return v1alpha1.WikiPageObservation{
+		Slug: w.Slug,
+	}
+}
+
+// IsWikiPageUpToDate checks whether the observed wiki page matches the desired
+// state.
+func IsWikiPageUpToDate(p *v1alpha1.WikiPageParameters, w *gitlab.GroupWiki) bool {
+	if p.Title != w.Title || p.Content != w.Content {
+		return false
+	}
+	return p.Format == nil || string(*p.Format) == string(w.Format)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLookupCacheTTL is the time a looked up project or group is cached
+// for. It is short so that changes in GitLab are picked up quickly.
+const DefaultLookupCacheTTL = 30 * time.Second
+
+type lookupCacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+// LookupCache caches the results of GitLab lookups for a short time, so that
+// controllers reconciling many resources of the same project or group do not
+// look it up again for each of them.
+type LookupCache[T any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]lookupCacheEntry[T]
+	now     func() time.Time
+}
+
+// NewLookupCache returns a LookupCache whose entries expire after ttl.
+func NewLookupCache[T any](ttl time.Duration) *LookupCache[T] {
+	return &LookupCache[T]{
+		ttl:     ttl,
+		entries: map[string]lookupCacheEntry[T]{},
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached value for key, calling lookup if there is none or
+// it has expired. Failed lookups are not cached.
+func (c *LookupCache[T]) Get(key string, lookup func() (T, error)) (T, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok && c.now().Before(e.expiresAt) {
+		c.mu.Unlock()
+		return e.value, nil
+	}
+	c.mu.Unlock()
+
+	v, err := lookup()
+	if err != nil {
+		return v, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = lookupCacheEntry[T]{value: v, expiresAt: now.Add(c.ttl)}
+	return v, nil
+}
+
+// LookupCacheKey returns a cache key for a lookup made with the given
+// configuration. Lookups made with different credentials are not shared, as
+// they may see different resources.
+func LookupCacheKey(cfg Config, parts ...string) string {
+	return strings.Join(append([]string{cfg.BaseURL, ValueChecksum(cfg.Token)}, parts...), "|")
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+func TestLookupCache(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type call struct {
+		key     string
+		after   time.Duration
+		lookup  int
+		failing bool
+	}
+	type want struct {
+		values  []int
+		errs    []error
+		lookups int
+	}
+
+	cases := map[string]struct {
+		calls []call
+		want  want
+	}{
+		"Cached": {
+			calls: []call{{key: "a", lookup: 1}, {key: "a", after: time.Second, lookup: 2}},
+			want:  want{values: []int{1, 1}, errs: []error{nil, nil}, lookups: 1},
+		},
+		"Expired": {
+			calls: []call{{key: "a", lookup: 1}, {key: "a", after: time.Minute, lookup: 2}},
+			want:  want{values: []int{1, 2}, errs: []error{nil, nil}, lookups: 2},
+		},
+		"DifferentKeys": {
+			calls: []call{{key: "a", lookup: 1}, {key: "b", lookup: 2}},
+			want:  want{values: []int{1, 2}, errs: []error{nil, nil}, lookups: 2},
+		},
+		"ErrorsNotCached": {
+			calls: []call{{key: "a", failing: true}, {key: "a", lookup: 2}},
+			want:  want{values: []int{0, 2}, errs: []error{errBoom, nil}, lookups: 2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewLookupCache[int](DefaultLookupCacheTTL)
+			elapsed := time.Duration(0)
+			c.now = func() time.Time { return now.Add(elapsed) }
+
+			lookups := 0
+			var values []int
+			var errs []error
+			for _, cl := range tc.calls {
+				elapsed += cl.after
+				v, err := c.Get(cl.key, func() (int, error) {
+					lookups++
+					if cl.failing {
+						return 0, errBoom
+					}
+					return cl.lookup, nil
+				})
+				values = append(values, v)
+				errs = append(errs, err)
+			}
+
+			if diff := cmp.Diff(tc.want.values, values); diff != "" {
+				t.Errorf("Get(...): -want values, +got values:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.errs, errs, test.EquateErrors()); diff != "" {
+				t.Errorf("Get(...): -want errors, +got errors:\n%s", diff)
+			}
+			if tc.want.lookups != lookups {
+				t.Errorf("Get(...): want %d lookups, got %d", tc.want.lookups, lookups)
+			}
+		})
+	}
+}
+
+func TestLookupCacheKey(t *testing.T) {
+	a := LookupCacheKey(Config{BaseURL: "https://gitlab.example.com", Token: "a"}, "project", "my-group/my-project")
+	b := LookupCacheKey(Config{BaseURL: "https://gitlab.example.com", Token: "b"}, "project", "my-group/my-project")
+	if a == b {
+		t.Errorf("LookupCacheKey(...): want different keys for different tokens, got %q", a)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricLabelEndpoint       = "endpoint"
+	metricLabelMethod         = "method"
+	metricLabelCode           = "code"
+	metricLabelProviderConfig = "provider_config"
+
+	// codeError is recorded as the code of requests that got no response.
+	codeError = "error"
+
+	apiPathPrefix = "/api/v4/"
+)
+
+// DefaultAPIMetrics records the requests of all clients created by NewClient.
+var DefaultAPIMetrics = NewAPIMetrics()
+
+// APIMetrics records the number and duration of requests made to the GitLab
+// API, by endpoint family, method, response code and ProviderConfig.
+type APIMetrics struct {
+	requests  *prometheus.CounterVec
+	duration  *prometheus.HistogramVec
+	connected *prometheus.GaugeVec
+}
+
+// NewAPIMetrics returns new, unregistered APIMetrics.
+func NewAPIMetrics() *APIMetrics {
+	return &APIMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: "gitlab",
+			Name:      "api_requests_total",
+			Help:      "Number of requests made to the GitLab API, by endpoint family, method, response code and ProviderConfig.",
+		}, []string{metricLabelEndpoint, metricLabelMethod, metricLabelCode, metricLabelProviderConfig}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: "gitlab",
+			Name:      "api_request_duration_seconds",
+			Help:      "Duration of requests made to the GitLab API, by endpoint family, method and ProviderConfig.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{metricLabelEndpoint, metricLabelMethod, metricLabelProviderConfig}),
+		connected: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Subsystem: "gitlab",
+			Name:      "provider_config_connected",
+			Help:      "Whether the last connectivity check of a ProviderConfig against the GitLab API succeeded (1) or not (0).",
+		}, []string{metricLabelProviderConfig}),
+	}
+}
+
+// Describe sends the descriptors of the metrics to ch.
+func (m *APIMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.duration.Describe(ch)
+	m.connected.Describe(ch)
+}
+
+// Collect sends the metrics to ch.
+func (m *APIMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.duration.Collect(ch)
+	m.connected.Collect(ch)
+}
+
+// SetConnected records the outcome of the last connectivity check of the
+// named ProviderConfig.
+func (m *APIMetrics) SetConnected(providerConfig string, connected bool) {
+	v := 0.0
+	if connected {
+		v = 1
+	}
+	m.connected.WithLabelValues(providerConfig).Set(v)
+}
+
+// DeleteConnected removes the connectivity of the named ProviderConfig, e.g.
+// once it has been deleted.
+func (m *APIMetrics) DeleteConnected(providerConfig string) {
+	m.connected.DeleteLabelValues(providerConfig)
+}
+
+// InstrumentRoundTripper returns a RoundTripper that records the requests
+// made through rt on behalf of the named ProviderConfig.
+func (m *APIMetrics) InstrumentRoundTripper(providerConfig string, rt http.RoundTripper) http.RoundTripper {
+	return &instrumentedRoundTripper{metrics: m, providerConfig: providerConfig, next: rt}
+}
+
+type instrumentedRoundTripper struct {
+	metrics        *APIMetrics
+	providerConfig string
+	next           http.RoundTripper
+}
+
+func (t *instrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := EndpointFamily(req.URL.EscapedPath())
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	t.metrics.duration.WithLabelValues(endpoint, req.Method, t.providerConfig).Observe(time.Since(start).Seconds())
+
+	code := codeError
+	if err == nil {
+		code = strconv.Itoa(res.StatusCode)
+	}
+	t.metrics.requests.WithLabelValues(endpoint, req.Method, code, t.providerConfig).Inc()
+	return res, err
+}
+
+// EndpointFamily returns the family of the GitLab API endpoint with the given
+// escaped path, e.g. projects/hooks for /api/v4/projects/1/hooks/2. GitLab
+// paths alternate between collections and IDs, so every other segment is
+// dropped to keep the number of families small.
+func EndpointFamily(path string) string {
+	if i := strings.Index(path, apiPathPrefix); i >= 0 {
+		path = path[i+len(apiPathPrefix):]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	family := make([]string, 0, (len(segments)+1)/2)
+	for i := 0; i < len(segments); i += 2 {
+		family = append(family, segments[i])
+	}
+	return strings.Join(family, "/")
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestEndpointFamily(t *testing.T) {
+	cases := map[string]struct {
+		path string
+		want string
+	}{
+		"Collection": {
+			path: "/api/v4/projects",
+			want: "projects",
+		},
+		"Item": {
+			path: "/api/v4/projects/1",
+			want: "projects",
+		},
+		"EncodedPath": {
+			path: "/api/v4/projects/my-group%2Fmy-project/hooks/2",
+			want: "projects/hooks",
+		},
+		"SubCollection": {
+			path: "/api/v4/groups/1/members",
+			want: "groups/members",
+		},
+		"Subpath": {
+			path: "/gitlab/api/v4/version",
+			want: "version",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, EndpointFamily(tc.path)); diff != "" {
+				t.Errorf("EndpointFamily(%q): -want, +got:\n%s", tc.path, diff)
+			}
+		})
+	}
+}
+
+func TestInstrumentRoundTripper(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/1" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	m := NewAPIMetrics()
+	c := &http.Client{Transport: m.InstrumentRoundTripper("default", http.DefaultTransport)}
+	for _, path := range []string{"/api/v4/projects/1", "/api/v4/projects/1", "/api/v4/projects/1/hooks/2"} {
+		res, err := c.Get(srv.URL + path)
+		if err != nil {
+			t.Fatalf("Get(%q): %v", path, err)
+		}
+		res.Body.Close() //nolint:errcheck // Nothing to do if closing fails.
+	}
+
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("projects", http.MethodGet, "429", "default")); got != 2 {
+		t.Errorf("projects requests: want 2, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("projects/hooks", http.MethodGet, "200", "default")); got != 1 {
+		t.Errorf("projects/hooks requests: want 1, got %v", got)
+	}
+	if got := testutil.CollectAndCount(m.duration); got != 2 {
+		t.Errorf("duration series: want 2, got %v", got)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"github.com/xanzy/go-gitlab"
+)
+
+const (
+	// DefaultPerPage is the page size used to iterate over GitLab list
+	// endpoints when none is configured.
+	DefaultPerPage = 100
+
+	// MaxPerPage is the largest page size GitLab accepts.
+	MaxPerPage = 100
+)
+
+// ListPageFn lists a single page of items using the supplied list options.
+type ListPageFn[T any] func(opt gitlab.ListOptions) ([]T, *gitlab.Response, error)
+
+// ListAll requests every page of a list endpoint and returns the items of
+// all pages. perPage is capped at MaxPerPage and defaults to DefaultPerPage
+// if it is not positive. The response of the last requested page is returned
+// alongside the items, or of the failed request on error.
+func ListAll[T any](perPage int, list ListPageFn[T]) ([]T, *gitlab.Response, error) {
+	opt := gitlab.ListOptions{Page: 1, PerPage: PerPage(perPage)}
+
+	var all []T
+	for {
+		items, res, err := list(opt)
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, items...)
+
+		if res == nil || res.NextPage == 0 || res.NextPage <= opt.Page {
+			return all, res, nil
+		}
+		opt.Page = res.NextPage
+	}
+}
+
+// PerPage returns the page size to request, given a configured one.
+func PerPage(perPage int) int {
+	switch {
+	case perPage <= 0:
+		return DefaultPerPage
+	case perPage > MaxPerPage:
+		return MaxPerPage
+	}
+	return perPage
+}
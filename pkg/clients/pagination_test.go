@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestListAll(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	// pages returns a ListPageFn serving items in pages of the requested
+	// size, and records the list options of each request.
+	pages := func(items []int, requested *[]gitlab.ListOptions) ListPageFn[int] {
+		return func(opt gitlab.ListOptions) ([]int, *gitlab.Response, error) {
+			*requested = append(*requested, opt)
+			start := (opt.Page - 1) * opt.PerPage
+			end := start + opt.PerPage
+			if end >= len(items) {
+				return items[start:], &gitlab.Response{}, nil
+			}
+			return items[start:end], &gitlab.Response{NextPage: opt.Page + 1}, nil
+		}
+	}
+	seq := func(n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = i
+		}
+		return s
+	}
+
+	type want struct {
+		items     []int
+		requested []gitlab.ListOptions
+		err       error
+	}
+
+	cases := map[string]struct {
+		perPage int
+		list    func(requested *[]gitlab.ListOptions) ListPageFn[int]
+		want    want
+	}{
+		"SinglePage": {
+			perPage: 10,
+			list:    func(r *[]gitlab.ListOptions) ListPageFn[int] { return pages(seq(5), r) },
+			want: want{
+				items:     seq(5),
+				requested: []gitlab.ListOptions{{Page: 1, PerPage: 10}},
+			},
+		},
+		"MultiplePages": {
+			perPage: 2,
+			list:    func(r *[]gitlab.ListOptions) ListPageFn[int] { return pages(seq(5), r) },
+			want: want{
+				items: seq(5),
+				requested: []gitlab.ListOptions{
+					{Page: 1, PerPage: 2},
+					{Page: 2, PerPage: 2},
+					{Page: 3, PerPage: 2},
+				},
+			},
+		},
+		"DefaultPerPage": {
+			list: func(r *[]gitlab.ListOptions) ListPageFn[int] { return pages(seq(150), r) },
+			want: want{
+				items: seq(150),
+				requested: []gitlab.ListOptions{
+					{Page: 1, PerPage: DefaultPerPage},
+					{Page: 2, PerPage: DefaultPerPage},
+				},
+			},
+		},
+		"CappedPerPage": {
+			perPage: 1000,
+			list:    func(r *[]gitlab.ListOptions) ListPageFn[int] { return pages(seq(3), r) },
+			want: want{
+				items:     seq(3),
+				requested: []gitlab.ListOptions{{Page: 1, PerPage: MaxPerPage}},
+			},
+		},
+		"Error": {
+			perPage: 2,
+			list: func(r *[]gitlab.ListOptions) ListPageFn[int] {
+				next := pages(seq(5), r)
+				return func(opt gitlab.ListOptions) ([]int, *gitlab.Response, error) {
+					if opt.Page == 2 {
+						*r = append(*r, opt)
+						return nil, nil, errBoom
+					}
+					return next(opt)
+				}
+			},
+			want: want{
+				requested: []gitlab.ListOptions{
+					{Page: 1, PerPage: 2},
+					{Page: 2, PerPage: 2},
+				},
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var requested []gitlab.ListOptions
+			items, _, err := ListAll(tc.perPage, tc.list(&requested))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ListAll(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.items, items); diff != "" {
+				t.Errorf("ListAll(...): -want items, +got items:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.requested, requested); diff != "" {
+				t.Errorf("ListAll(...): -want requests, +got requests:\n%s", diff)
+			}
+		})
+	}
+}
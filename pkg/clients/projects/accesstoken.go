@@ -18,8 +18,10 @@ package projects
 
 import (
 	"strings"
+	"time"
 
 	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
@@ -29,6 +31,7 @@ import (
 type AccessTokenClient interface {
 	GetProjectAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	CreateProjectAccessToken(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
+	RotateProjectAccessToken(pid interface{}, id int, opt *gitlab.RotateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	RevokeProjectAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 }
 
@@ -55,6 +58,8 @@ func GenerateCreateProjectAccessTokenOptions(name string, p *v1alpha1.AccessToke
 
 	if p.ExpiresAt != nil {
 		accesstoken.ExpiresAt = (*gitlab.ISOTime)(&p.ExpiresAt.Time)
+	} else if p.ExpiresAtPolicy != nil {
+		accesstoken.ExpiresAt = expiresAtFromPolicy(p.ExpiresAtPolicy, time.Now())
 	}
 
 	if p.AccessLevel != nil {
@@ -63,3 +68,47 @@ func GenerateCreateProjectAccessTokenOptions(name string, p *v1alpha1.AccessToke
 
 	return accesstoken
 }
+
+// GenerateRotateProjectAccessTokenOptions generates access token rotation options
+// from the expiresAt policy.
+func GenerateRotateProjectAccessTokenOptions(p *v1alpha1.AccessTokenParameters, now time.Time) *gitlab.RotateProjectAccessTokenOptions {
+	opts := &gitlab.RotateProjectAccessTokenOptions{}
+	if p.ExpiresAtPolicy != nil {
+		opts.ExpiresAt = expiresAtFromPolicy(p.ExpiresAtPolicy, now)
+	}
+	return opts
+}
+
+// GenerateProjectAccessTokenObservation is used to produce
+// v1alpha1.AccessTokenObservation from gitlab.ProjectAccessToken.
+func GenerateProjectAccessTokenObservation(at *gitlab.ProjectAccessToken) v1alpha1.AccessTokenObservation {
+	if at == nil {
+		return v1alpha1.AccessTokenObservation{}
+	}
+
+	o := v1alpha1.AccessTokenObservation{
+		TokenID:    gitlab.Ptr(at.ID),
+		LastUsedAt: clients.TimeToMetaTime(at.LastUsedAt),
+		Active:     at.Active,
+		Revoked:    at.Revoked,
+	}
+	if at.ExpiresAt != nil {
+		o.ExpiresAt = &metav1.Time{Time: time.Time(*at.ExpiresAt)}
+	}
+	return o
+}
+
+// IsProjectAccessTokenExpiring returns true if the expiresAt policy requires the
+// access token to be rotated at the given time.
+func IsProjectAccessTokenExpiring(p *v1alpha1.AccessTokenParameters, at *gitlab.ProjectAccessToken, now time.Time) bool {
+	if p.ExpiresAtPolicy == nil || at == nil || at.ExpiresAt == nil {
+		return false
+	}
+	rotateAt := time.Time(*at.ExpiresAt).AddDate(0, 0, -p.ExpiresAtPolicy.RotateDaysBefore)
+	return !now.Before(rotateAt)
+}
+
+func expiresAtFromPolicy(p *v1alpha1.ExpiresAtPolicy, now time.Time) *gitlab.ISOTime {
+	expiresAt := gitlab.ISOTime(now.AddDate(0, 0, p.ValidityDays))
+	return &expiresAt
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// CommitClient defines Gitlab repository commit service operations
+type CommitClient interface {
+	GetCommit(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error)
+	CreateCommit(pid interface{}, opt *gitlab.CreateCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error)
+}
+
+// NewCommitClient returns a new Gitlab repository commit service
+func NewCommitClient(cfg clients.Config) CommitClient {
+	git := clients.NewClient(cfg)
+	return git.Commits
+}
+
+// GenerateCreateCommitOptions generates commit creation options
+func GenerateCreateCommitOptions(p *v1alpha1.CommitParameters) *gitlab.CreateCommitOptions {
+	actions := make([]*gitlab.CommitActionOptions, len(p.Actions))
+	for i, a := range p.Actions {
+		actions[i] = &gitlab.CommitActionOptions{
+			Action:          gitlab.FileAction(gitlab.FileActionValue(a.Action)),
+			FilePath:        gitlab.Ptr(a.FilePath),
+			PreviousPath:    a.PreviousPath,
+			Content:         a.Content,
+			Encoding:        a.Encoding,
+			LastCommitID:    a.LastCommitID,
+			ExecuteFilemode: a.ExecuteFilemode,
+		}
+	}
+
+	return &gitlab.CreateCommitOptions{
+		Branch:        &p.Branch,
+		CommitMessage: &p.CommitMessage,
+		StartBranch:   p.StartBranch,
+		StartSHA:      p.StartSHA,
+		Actions:       actions,
+		AuthorEmail:   p.AuthorEmail,
+		AuthorName:    p.AuthorName,
+		Force:         p.Force,
+	}
+}
+
+// GenerateCommitObservation is used to produce v1alpha1.CommitObservation
+// from gitlab.Commit.
+func GenerateCommitObservation(c *gitlab.Commit) v1alpha1.CommitObservation {
+	if c == nil {
+		return v1alpha1.CommitObservation{}
+	}
+
+	return v1alpha1.CommitObservation{
+		ID:            c.ID,
+		ShortID:       c.ShortID,
+		Title:         c.Title,
+		AuthorName:    c.AuthorName,
+		AuthorEmail:   c.AuthorEmail,
+		CommittedDate: clients.TimeToMetaTime(c.CommittedDate),
+		WebURL:        c.WebURL,
+	}
+}
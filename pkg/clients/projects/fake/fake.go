@@ -33,6 +33,8 @@ type MockClient struct {
 	MockEditProject   func(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	MockDeleteProject func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
+	MockStartHousekeepingProject func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
 	MockGetHook    func(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	MockAddHook    func(pid interface{}, opt *gitlab.AddProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
 	MockEditHook   func(pid interface{}, hook int, opt *gitlab.EditProjectHookOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error)
@@ -55,6 +57,7 @@ type MockClient struct {
 
 	MockGetProjectAccessToken    func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	MockCreateProjectAccessToken func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
+	MockRotateProjectAccessToken func(pid interface{}, id int, opt *gitlab.RotateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error)
 	MockRevokeProjectAccessToken func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
 
 	MockAddDeployKey    func(pid interface{}, opt *gitlab.AddDeployKeyOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectDeployKey, *gitlab.Response, error)
@@ -70,6 +73,54 @@ type MockClient struct {
 	MockEditPipelineScheduleVariable   func(pid interface{}, schedule int, key string, opt *gitlab.EditPipelineScheduleVariableOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 	MockDeletePipelineScheduleVariable func(pid interface{}, schedule int, key string, options ...gitlab.RequestOptionFunc) (*gitlab.PipelineVariable, *gitlab.Response, error)
 
+	MockGetProjectSecuritySettings    func(pid interface{}, options ...gitlab.RequestOptionFunc) (*projects.ProjectSecuritySettings, *gitlab.Response, error)
+	MockUpdateProjectSecuritySettings func(pid interface{}, opt *projects.UpdateProjectSecuritySettingsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectSecuritySettings, *gitlab.Response, error)
+
+	MockGetProjectPagesSettings    func(pid interface{}, options ...gitlab.RequestOptionFunc) (*projects.ProjectPagesSettings, *gitlab.Response, error)
+	MockUpdateProjectPagesSettings func(pid interface{}, opt *projects.UpdateProjectPagesSettingsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectPagesSettings, *gitlab.Response, error)
+
+	MockGetSecurityPolicyProject      func(fullPath string, options ...gitlab.RequestOptionFunc) (*projects.SecurityPolicyProject, *gitlab.Response, error)
+	MockAssignSecurityPolicyProject   func(fullPath string, policyProjectID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	MockUnassignSecurityPolicyProject func(fullPath string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetProjectAlias    func(name string, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error)
+	MockCreateProjectAlias func(opt *projects.CreateProjectAliasOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error)
+	MockDeleteProjectAlias func(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetRegistryProtectionRule    func(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error)
+	MockCreateRegistryProtectionRule func(pid interface{}, opt *projects.RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error)
+	MockUpdateRegistryProtectionRule func(pid interface{}, rule int, opt *projects.RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error)
+	MockDeleteRegistryProtectionRule func(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetSnippet     func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	MockSnippetContent func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error)
+	MockCreateSnippet  func(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	MockUpdateSnippet  func(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	MockDeleteSnippet  func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetWikiPage    func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockCreateWikiPage func(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockEditWikiPage   func(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error)
+	MockDeleteWikiPage func(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetCommit    func(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error)
+	MockCreateCommit func(pid interface{}, opt *gitlab.CreateCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error)
+
+	MockGetFeatureFlag    func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error)
+	MockCreateFeatureFlag func(pid interface{}, opt *projects.FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error)
+	MockUpdateFeatureFlag func(pid interface{}, name string, opt *projects.FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error)
+	MockDeleteFeatureFlag func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetFeatureFlagUserList    func(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error)
+	MockCreateFeatureFlagUserList func(pid interface{}, opt *projects.FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error)
+	MockUpdateFeatureFlagUserList func(pid interface{}, iid int, opt *projects.FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error)
+	MockDeleteFeatureFlagUserList func(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+	MockGetIssue    func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	MockCreateIssue func(pid interface{}, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	MockUpdateIssue func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	MockDeleteIssue func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
 	MockListUsers func(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error)
 }
 
@@ -128,6 +179,21 @@ func (c *MockClient) DeleteProject(pid interface{}, options ...gitlab.RequestOpt
 	return c.MockDeleteProject(pid)
 }
 
+// StartHousekeepingProject calls the underlying MockStartHousekeepingProject method
+func (c *MockClient) StartHousekeepingProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockStartHousekeepingProject(pid)
+}
+
+// GetProjectSecuritySettings calls the underlying MockGetProjectSecuritySettings method.
+func (c *MockClient) GetProjectSecuritySettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*projects.ProjectSecuritySettings, *gitlab.Response, error) {
+	return c.MockGetProjectSecuritySettings(pid)
+}
+
+// UpdateProjectSecuritySettings calls the underlying MockUpdateProjectSecuritySettings method.
+func (c *MockClient) UpdateProjectSecuritySettings(pid interface{}, opt *projects.UpdateProjectSecuritySettingsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectSecuritySettings, *gitlab.Response, error) {
+	return c.MockUpdateProjectSecuritySettings(pid, opt)
+}
+
 // GetProjectHook calls the underlying MockGetProjectHook method.
 func (c *MockClient) GetProjectHook(pid interface{}, hook int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectHook, *gitlab.Response, error) {
 	return c.MockGetHook(pid, hook)
@@ -243,6 +309,11 @@ func (c *MockClient) CreateProjectAccessToken(pid interface{}, opt *gitlab.Creat
 	return c.MockCreateProjectAccessToken(pid, opt)
 }
 
+// RotateProjectAccessToken calls the underlying MockRotateProjectAccessToken method.
+func (c *MockClient) RotateProjectAccessToken(pid interface{}, id int, opt *gitlab.RotateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+	return c.MockRotateProjectAccessToken(pid, id, opt)
+}
+
 // RevokeProjectAccessToken calls the underlying MockRevokeProjectAccessToken method.
 func (c *MockClient) RevokeProjectAccessToken(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
 	return c.MockRevokeProjectAccessToken(pid, id)
@@ -252,3 +323,178 @@ func (c *MockClient) RevokeProjectAccessToken(pid interface{}, id int, options .
 func (c *MockClient) ListUsers(opt *gitlab.ListUsersOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.User, *gitlab.Response, error) {
 	return c.MockListUsers(opt)
 }
+
+// GetIssue calls the underlying MockGetIssue method.
+func (c *MockClient) GetIssue(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockGetIssue(pid, issue)
+}
+
+// CreateIssue calls the underlying MockCreateIssue method.
+func (c *MockClient) CreateIssue(pid interface{}, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockCreateIssue(pid, opt)
+}
+
+// UpdateIssue calls the underlying MockUpdateIssue method.
+func (c *MockClient) UpdateIssue(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+	return c.MockUpdateIssue(pid, issue, opt)
+}
+
+// DeleteIssue calls the underlying MockDeleteIssue method.
+func (c *MockClient) DeleteIssue(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteIssue(pid, issue)
+}
+
+// GetSecurityPolicyProject calls the underlying MockGetSecurityPolicyProject method.
+func (c *MockClient) GetSecurityPolicyProject(fullPath string, options ...gitlab.RequestOptionFunc) (*projects.SecurityPolicyProject, *gitlab.Response, error) {
+	return c.MockGetSecurityPolicyProject(fullPath)
+}
+
+// AssignSecurityPolicyProject calls the underlying MockAssignSecurityPolicyProject method.
+func (c *MockClient) AssignSecurityPolicyProject(fullPath string, policyProjectID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockAssignSecurityPolicyProject(fullPath, policyProjectID)
+}
+
+// UnassignSecurityPolicyProject calls the underlying MockUnassignSecurityPolicyProject method.
+func (c *MockClient) UnassignSecurityPolicyProject(fullPath string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockUnassignSecurityPolicyProject(fullPath)
+}
+
+// GetRegistryProtectionRule calls the underlying MockGetRegistryProtectionRule method.
+func (c *MockClient) GetRegistryProtectionRule(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+	return c.MockGetRegistryProtectionRule(pid, rule)
+}
+
+// CreateRegistryProtectionRule calls the underlying MockCreateRegistryProtectionRule method.
+func (c *MockClient) CreateRegistryProtectionRule(pid interface{}, opt *projects.RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+	return c.MockCreateRegistryProtectionRule(pid, opt)
+}
+
+// UpdateRegistryProtectionRule calls the underlying MockUpdateRegistryProtectionRule method.
+func (c *MockClient) UpdateRegistryProtectionRule(pid interface{}, rule int, opt *projects.RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+	return c.MockUpdateRegistryProtectionRule(pid, rule, opt)
+}
+
+// DeleteRegistryProtectionRule calls the underlying MockDeleteRegistryProtectionRule method.
+func (c *MockClient) DeleteRegistryProtectionRule(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteRegistryProtectionRule(pid, rule)
+}
+
+// GetSnippet calls the underlying MockGetSnippet method.
+func (c *MockClient) GetSnippet(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+	return c.MockGetSnippet(pid, snippet)
+}
+
+// SnippetContent calls the underlying MockSnippetContent method.
+func (c *MockClient) SnippetContent(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+	return c.MockSnippetContent(pid, snippet)
+}
+
+// CreateSnippet calls the underlying MockCreateSnippet method.
+func (c *MockClient) CreateSnippet(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+	return c.MockCreateSnippet(pid, opt)
+}
+
+// UpdateSnippet calls the underlying MockUpdateSnippet method.
+func (c *MockClient) UpdateSnippet(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+	return c.MockUpdateSnippet(pid, snippet, opt)
+}
+
+// DeleteSnippet calls the underlying MockDeleteSnippet method.
+func (c *MockClient) DeleteSnippet(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteSnippet(pid, snippet)
+}
+
+// GetWikiPage calls the underlying MockGetWikiPage method.
+func (c *MockClient) GetWikiPage(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockGetWikiPage(pid, slug, opt)
+}
+
+// CreateWikiPage calls the underlying MockCreateWikiPage method.
+func (c *MockClient) CreateWikiPage(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockCreateWikiPage(pid, opt)
+}
+
+// EditWikiPage calls the underlying MockEditWikiPage method.
+func (c *MockClient) EditWikiPage(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+	return c.MockEditWikiPage(pid, slug, opt)
+}
+
+// DeleteWikiPage calls the underlying MockDeleteWikiPage method.
+func (c *MockClient) DeleteWikiPage(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteWikiPage(pid, slug)
+}
+
+// GetCommit calls the underlying MockGetCommit method.
+func (c *MockClient) GetCommit(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+	return c.MockGetCommit(pid, sha, opt)
+}
+
+// CreateCommit calls the underlying MockCreateCommit method.
+func (c *MockClient) CreateCommit(pid interface{}, opt *gitlab.CreateCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+	return c.MockCreateCommit(pid, opt)
+}
+
+// GetFeatureFlag calls the underlying MockGetFeatureFlag method.
+func (c *MockClient) GetFeatureFlag(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+	return c.MockGetFeatureFlag(pid, name)
+}
+
+// CreateFeatureFlag calls the underlying MockCreateFeatureFlag method.
+func (c *MockClient) CreateFeatureFlag(pid interface{}, opt *projects.FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+	return c.MockCreateFeatureFlag(pid, opt)
+}
+
+// UpdateFeatureFlag calls the underlying MockUpdateFeatureFlag method.
+func (c *MockClient) UpdateFeatureFlag(pid interface{}, name string, opt *projects.FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+	return c.MockUpdateFeatureFlag(pid, name, opt)
+}
+
+// DeleteFeatureFlag calls the underlying MockDeleteFeatureFlag method.
+func (c *MockClient) DeleteFeatureFlag(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteFeatureFlag(pid, name)
+}
+
+// GetFeatureFlagUserList calls the underlying MockGetFeatureFlagUserList method.
+func (c *MockClient) GetFeatureFlagUserList(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+	return c.MockGetFeatureFlagUserList(pid, iid)
+}
+
+// CreateFeatureFlagUserList calls the underlying MockCreateFeatureFlagUserList method.
+func (c *MockClient) CreateFeatureFlagUserList(pid interface{}, opt *projects.FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+	return c.MockCreateFeatureFlagUserList(pid, opt)
+}
+
+// UpdateFeatureFlagUserList calls the underlying MockUpdateFeatureFlagUserList method.
+func (c *MockClient) UpdateFeatureFlagUserList(pid interface{}, iid int, opt *projects.FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+	return c.MockUpdateFeatureFlagUserList(pid, iid, opt)
+}
+
+// DeleteFeatureFlagUserList calls the underlying MockDeleteFeatureFlagUserList method.
+func (c *MockClient) DeleteFeatureFlagUserList(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteFeatureFlagUserList(pid, iid)
+}
+
+// GetProjectPagesSettings calls the underlying MockGetProjectPagesSettings method.
+func (c *MockClient) GetProjectPagesSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*projects.ProjectPagesSettings, *gitlab.Response, error) {
+	return c.MockGetProjectPagesSettings(pid)
+}
+
+// UpdateProjectPagesSettings calls the underlying MockUpdateProjectPagesSettings method.
+func (c *MockClient) UpdateProjectPagesSettings(pid interface{}, opt *projects.UpdateProjectPagesSettingsOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectPagesSettings, *gitlab.Response, error) {
+	return c.MockUpdateProjectPagesSettings(pid, opt)
+}
+
+// GetProjectAlias calls the underlying MockGetProjectAlias method.
+func (c *MockClient) GetProjectAlias(name string, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+	return c.MockGetProjectAlias(name)
+}
+
+// CreateProjectAlias calls the underlying MockCreateProjectAlias method.
+func (c *MockClient) CreateProjectAlias(opt *projects.CreateProjectAliasOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+	return c.MockCreateProjectAlias(opt)
+}
+
+// DeleteProjectAlias calls the underlying MockDeleteProjectAlias method.
+func (c *MockClient) DeleteProjectAlias(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	return c.MockDeleteProjectAlias(name)
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// featureFlagVersion is the only feature flag version GitLab still supports
+// creating.
+const featureFlagVersion = "new_version_flag"
+
+// FeatureFlag represents a project feature flag. The go-gitlab client cannot
+// remove strategies or scopes of an existing flag, so the API is modelled
+// here.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flags.html
+type FeatureFlag struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Active      bool                   `json:"active"`
+	Version     string                 `json:"version"`
+	CreatedAt   *time.Time             `json:"created_at"`
+	UpdatedAt   *time.Time             `json:"updated_at"`
+	Strategies  []*FeatureFlagStrategy `json:"strategies"`
+}
+
+// FeatureFlagStrategy represents a strategy of a project feature flag.
+type FeatureFlagStrategy struct {
+	ID         int                                         `json:"id"`
+	Name       string                                      `json:"name"`
+	Parameters *gitlab.ProjectFeatureFlagStrategyParameter `json:"parameters"`
+	Scopes     []*gitlab.ProjectFeatureFlagScope           `json:"scopes"`
+	UserList   *FeatureFlagUserList                        `json:"user_list"`
+}
+
+// FeatureFlagOptions represents the available CreateFeatureFlag() and
+// UpdateFeatureFlag() options.
+type FeatureFlagOptions struct {
+	Name        *string                       `url:"name,omitempty" json:"name,omitempty"`
+	Description *string                       `url:"description,omitempty" json:"description,omitempty"`
+	Version     *string                       `url:"version,omitempty" json:"version,omitempty"`
+	Active      *bool                         `url:"active,omitempty" json:"active,omitempty"`
+	Strategies  []*FeatureFlagStrategyOptions `url:"strategies,omitempty" json:"strategies,omitempty"`
+}
+
+// FeatureFlagStrategyOptions represents a strategy of the FeatureFlagOptions.
+// Existing strategies are referenced by ID, and removed by setting Destroy.
+type FeatureFlagStrategyOptions struct {
+	ID         *int                                        `url:"id,omitempty" json:"id,omitempty"`
+	Name       *string                                     `url:"name,omitempty" json:"name,omitempty"`
+	Parameters *gitlab.ProjectFeatureFlagStrategyParameter `url:"parameters,omitempty" json:"parameters,omitempty"`
+	Scopes     []*FeatureFlagScopeOptions                  `url:"scopes,omitempty" json:"scopes,omitempty"`
+	UserListID *int                                        `url:"user_list_id,omitempty" json:"user_list_id,omitempty"`
+	Destroy    *bool                                       `url:"_destroy,omitempty" json:"_destroy,omitempty"`
+}
+
+// FeatureFlagScopeOptions represents a scope of the
+// FeatureFlagStrategyOptions. Existing scopes are referenced by ID, and
+// removed by setting Destroy.
+type FeatureFlagScopeOptions struct {
+	ID               *int    `url:"id,omitempty" json:"id,omitempty"`
+	EnvironmentScope *string `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+	Destroy          *bool   `url:"_destroy,omitempty" json:"_destroy,omitempty"`
+}
+
+// FeatureFlagClient defines Gitlab project feature flag operations
+type FeatureFlagClient interface {
+	GetFeatureFlag(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*FeatureFlag, *gitlab.Response, error)
+	CreateFeatureFlag(pid interface{}, opt *FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*FeatureFlag, *gitlab.Response, error)
+	UpdateFeatureFlag(pid interface{}, name string, opt *FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*FeatureFlag, *gitlab.Response, error)
+	DeleteFeatureFlag(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type featureFlagService struct {
+	client *gitlab.Client
+}
+
+// NewFeatureFlagClient returns a new Gitlab project feature flag service
+func NewFeatureFlagClient(cfg clients.Config) FeatureFlagClient {
+	git := clients.NewClient(cfg)
+	return &featureFlagService{client: git}
+}
+
+func featureFlagsPath(pid interface{}) string {
+	return fmt.Sprintf("projects/%s/feature_flags", gitlab.PathEscape(fmt.Sprint(pid)))
+}
+
+func featureFlagPath(pid interface{}, name string) string {
+	return fmt.Sprintf("%s/%s", featureFlagsPath(pid), gitlab.PathEscape(name))
+}
+
+// GetFeatureFlag gets a single project feature flag.
+func (s *featureFlagService) GetFeatureFlag(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*FeatureFlag, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, featureFlagPath(pid, name), nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f := new(FeatureFlag)
+	resp, err := s.client.Do(req, f)
+	if err != nil {
+		return nil, resp, err
+	}
+	return f, resp, nil
+}
+
+// CreateFeatureFlag creates a project feature flag.
+func (s *featureFlagService) CreateFeatureFlag(pid interface{}, opt *FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*FeatureFlag, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, featureFlagsPath(pid), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f := new(FeatureFlag)
+	resp, err := s.client.Do(req, f)
+	if err != nil {
+		return nil, resp, err
+	}
+	return f, resp, nil
+}
+
+// UpdateFeatureFlag updates a project feature flag.
+func (s *featureFlagService) UpdateFeatureFlag(pid interface{}, name string, opt *FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*FeatureFlag, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodPut, featureFlagPath(pid, name), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f := new(FeatureFlag)
+	resp, err := s.client.Do(req, f)
+	if err != nil {
+		return nil, resp, err
+	}
+	return f, resp, nil
+}
+
+// DeleteFeatureFlag deletes a project feature flag.
+func (s *featureFlagService) DeleteFeatureFlag(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, featureFlagPath(pid, name), nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(req, nil)
+}
+
+// GenerateCreateFeatureFlagOptions generates project feature flag creation
+// options
+func GenerateCreateFeatureFlagOptions(p *v1alpha1.FeatureFlagParameters) *FeatureFlagOptions {
+	opt := &FeatureFlagOptions{
+		Name:        &p.Name,
+		Description: p.Description,
+		Version:     gitlab.Ptr(featureFlagVersion),
+		Active:      p.Active,
+	}
+	for i := range p.Strategies {
+		opt.Strategies = append(opt.Strategies, generateFeatureFlagStrategyOptions(&p.Strategies[i], nil))
+	}
+	return opt
+}
+
+// GenerateUpdateFeatureFlagOptions generates project feature flag update
+// options. Desired strategies and scopes replace the observed ones at the
+// same position, and observed ones without a desired counterpart are
+// removed.
+func GenerateUpdateFeatureFlagOptions(p *v1alpha1.FeatureFlagParameters, f *FeatureFlag) *FeatureFlagOptions {
+	opt := &FeatureFlagOptions{
+		Description: p.Description,
+		Active:      p.Active,
+	}
+	for i := range p.Strategies {
+		var observed *FeatureFlagStrategy
+		if i < len(f.Strategies) {
+			observed = f.Strategies[i]
+		}
+		opt.Strategies = append(opt.Strategies, generateFeatureFlagStrategyOptions(&p.Strategies[i], observed))
+	}
+	for i := len(p.Strategies); i < len(f.Strategies); i++ {
+		opt.Strategies = append(opt.Strategies, &FeatureFlagStrategyOptions{
+			ID:      gitlab.Ptr(f.Strategies[i].ID),
+			Destroy: gitlab.Ptr(true),
+		})
+	}
+	return opt
+}
+
+func generateFeatureFlagStrategyOptions(s *v1alpha1.FeatureFlagStrategy, observed *FeatureFlagStrategy) *FeatureFlagStrategyOptions {
+	opt := &FeatureFlagStrategyOptions{
+		Name:       gitlab.Ptr(string(s.Name)),
+		Parameters: featureFlagStrategyParameter(s.Parameters),
+	}
+	if s.UserListID != nil {
+		opt.UserListID = gitlab.Ptr(int(*s.UserListID))
+	}
+	var observedScopes []*gitlab.ProjectFeatureFlagScope
+	if observed != nil {
+		opt.ID = gitlab.Ptr(observed.ID)
+		observedScopes = observed.Scopes
+	}
+	for i := range s.Scopes {
+		scope := &FeatureFlagScopeOptions{EnvironmentScope: &s.Scopes[i].EnvironmentScope}
+		if i < len(observedScopes) {
+			scope.ID = gitlab.Ptr(observedScopes[i].ID)
+		}
+		opt.Scopes = append(opt.Scopes, scope)
+	}
+	for i := len(s.Scopes); i < len(observedScopes); i++ {
+		opt.Scopes = append(opt.Scopes, &FeatureFlagScopeOptions{
+			ID:      gitlab.Ptr(observedScopes[i].ID),
+			Destroy: gitlab.Ptr(true),
+		})
+	}
+	return opt
+}
+
+func featureFlagStrategyParameter(p *v1alpha1.FeatureFlagStrategyParameters) *gitlab.ProjectFeatureFlagStrategyParameter {
+	if p == nil {
+		return &gitlab.ProjectFeatureFlagStrategyParameter{}
+	}
+	return &gitlab.ProjectFeatureFlagStrategyParameter{
+		GroupID:    ptr.Deref(p.GroupID, ""),
+		UserIDs:    ptr.Deref(p.UserIDs, ""),
+		Percentage: ptr.Deref(p.Percentage, ""),
+		Rollout:    ptr.Deref(p.Rollout, ""),
+		Stickiness: ptr.Deref(p.Stickiness, ""),
+	}
+}
+
+// GenerateFeatureFlagObservation is used to produce
+// v1alpha1.FeatureFlagObservation from FeatureFlag.
+func GenerateFeatureFlagObservation(f *FeatureFlag) v1alpha1.FeatureFlagObservation {
+	if f == nil {
+		return v1alpha1.FeatureFlagObservation{}
+	}
+	return v1alpha1.FeatureFlagObservation{
+		Version:   f.Version,
+		CreatedAt: clients.TimeToMetaTime(f.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(f.UpdatedAt),
+	}
+}
+
+// IsFeatureFlagUpToDate checks whether the observed feature flag matches the
+// desired state. Strategies and their scopes are compared in order.
+func IsFeatureFlagUpToDate(p *v1alpha1.FeatureFlagParameters, f *FeatureFlag) bool {
+	if !clients.IsStringEqualToStringPtr(p.Description, f.Description) {
+		return false
+	}
+	if p.Active != nil && *p.Active != f.Active {
+		return false
+	}
+	if len(p.Strategies) != len(f.Strategies) {
+		return false
+	}
+	for i, s := range p.Strategies {
+		if !isFeatureFlagStrategyUpToDate(&s, f.Strategies[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isFeatureFlagStrategyUpToDate(s *v1alpha1.FeatureFlagStrategy, observed *FeatureFlagStrategy) bool {
+	if string(s.Name) != observed.Name {
+		return false
+	}
+	params := gitlab.ProjectFeatureFlagStrategyParameter{}
+	if observed.Parameters != nil {
+		params = *observed.Parameters
+	}
+	if *featureFlagStrategyParameter(s.Parameters) != params {
+		return false
+	}
+	if s.UserListID != nil && (observed.UserList == nil || int(*s.UserListID) != observed.UserList.ID) {
+		return false
+	}
+	if len(s.Scopes) != len(observed.Scopes) {
+		return false
+	}
+	for i, scope := range s.Scopes {
+		if scope.EnvironmentScope != observed.Scopes[i].EnvironmentScope {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// FeatureFlagUserList represents a project feature flag user list. The
+// go-gitlab client does not model this API yet.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flag_user_lists.html
+type FeatureFlagUserList struct {
+	ID        int        `json:"id"`
+	IID       int        `json:"iid"`
+	ProjectID int        `json:"project_id"`
+	Name      string     `json:"name"`
+	UserXIDs  string     `json:"user_xids"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// FeatureFlagUserListOptions represents the available
+// CreateFeatureFlagUserList() and UpdateFeatureFlagUserList() options.
+type FeatureFlagUserListOptions struct {
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
+	UserXIDs *string `url:"user_xids,omitempty" json:"user_xids,omitempty"`
+}
+
+// FeatureFlagUserListClient defines Gitlab project feature flag user list
+// operations
+type FeatureFlagUserListClient interface {
+	GetFeatureFlagUserList(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*FeatureFlagUserList, *gitlab.Response, error)
+	CreateFeatureFlagUserList(pid interface{}, opt *FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*FeatureFlagUserList, *gitlab.Response, error)
+	UpdateFeatureFlagUserList(pid interface{}, iid int, opt *FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*FeatureFlagUserList, *gitlab.Response, error)
+	DeleteFeatureFlagUserList(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type featureFlagUserListService struct {
+	client *gitlab.Client
+}
+
+// NewFeatureFlagUserListClient returns a new Gitlab project feature flag user
+// list service
+func NewFeatureFlagUserListClient(cfg clients.Config) FeatureFlagUserListClient {
+	git := clients.NewClient(cfg)
+	return &featureFlagUserListService{client: git}
+}
+
+func featureFlagUserListsPath(pid interface{}) string {
+	return fmt.Sprintf("projects/%s/feature_flags_user_lists", gitlab.PathEscape(fmt.Sprint(pid)))
+}
+
+// GetFeatureFlagUserList gets a single project feature flag user list.
+func (s *featureFlagUserListService) GetFeatureFlagUserList(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*FeatureFlagUserList, *gitlab.Response, error) {
+	u := fmt.Sprintf("%s/%d", featureFlagUserListsPath(pid), iid)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l, resp, nil
+}
+
+// CreateFeatureFlagUserList creates a project feature flag user list.
+func (s *featureFlagUserListService) CreateFeatureFlagUserList(pid interface{}, opt *FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*FeatureFlagUserList, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, featureFlagUserListsPath(pid), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l, resp, nil
+}
+
+// UpdateFeatureFlagUserList updates a project feature flag user list.
+func (s *featureFlagUserListService) UpdateFeatureFlagUserList(pid interface{}, iid int, opt *FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*FeatureFlagUserList, *gitlab.Response, error) {
+	u := fmt.Sprintf("%s/%d", featureFlagUserListsPath(pid), iid)
+	req, err := s.client.NewRequest(http.MethodPut, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+	return l, resp, nil
+}
+
+// DeleteFeatureFlagUserList deletes a project feature flag user list.
+func (s *featureFlagUserListService) DeleteFeatureFlagUserList(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	u := fmt.Sprintf("%s/%d", featureFlagUserListsPath(pid), iid)
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(req, nil)
+}
+
+// GenerateFeatureFlagUserListOptions generates project feature flag user list
+// creation and update options
+func GenerateFeatureFlagUserListOptions(p *v1alpha1.FeatureFlagUserListParameters) *FeatureFlagUserListOptions {
+	return &FeatureFlagUserListOptions{
+		Name:     &p.Name,
+		UserXIDs: gitlab.Ptr(strings.Join(p.UserXIDs, ",")),
+	}
+}
+
+// GenerateFeatureFlagUserListObservation is used to produce
+// v1alpha1.FeatureFlagUserListObservation from FeatureFlagUserList.
+func GenerateFeatureFlagUserListObservation(l *FeatureFlagUserList) v1alpha1.FeatureFlagUserListObservation {
+	if l == nil {
+		return v1alpha1.FeatureFlagUserListObservation{}
+	}
+	return v1alpha1.FeatureFlagUserListObservation{
+		ID:        l.ID,
+		IID:       l.IID,
+		CreatedAt: clients.TimeToMetaTime(l.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(l.UpdatedAt),
+	}
+}
+
+// IsFeatureFlagUserListUpToDate checks whether the observed user list matches
+// the desired state. The order of the users is ignored.
+func IsFeatureFlagUserListUpToDate(p *v1alpha1.FeatureFlagUserListParameters, l *FeatureFlagUserList) bool {
+	if p.Name != l.Name {
+		return false
+	}
+	return strings.Join(sortedUserXIDs(p.UserXIDs), ",") == strings.Join(sortedUserXIDs(strings.Split(l.UserXIDs, ",")), ",")
+}
+
+func sortedUserXIDs(xids []string) []string {
+	sorted := make([]string, 0, len(xids))
+	for _, x := range xids {
+		if x = strings.TrimSpace(x); x != "" {
+			sorted = append(sorted, x)
+		}
+	}
+	sort.Strings(sorted)
+	return sorted
+}
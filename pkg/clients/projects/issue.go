@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// IssueClient defines Gitlab Issue service operations
+type IssueClient interface {
+	GetIssue(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	CreateIssue(pid interface{}, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	UpdateIssue(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error)
+	DeleteIssue(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewIssueClient returns a new Gitlab Issue service
+func NewIssueClient(cfg clients.Config) IssueClient {
+	git := clients.NewClient(cfg)
+	return git.Issues
+}
+
+// GenerateCreateIssueOptions generates issue creation options
+func GenerateCreateIssueOptions(p *v1alpha1.IssueParameters) *gitlab.CreateIssueOptions {
+	opt := &gitlab.CreateIssueOptions{
+		Title:        &p.Title,
+		Description:  p.Description,
+		Confidential: p.Confidential,
+		MilestoneID:  p.MilestoneID,
+	}
+
+	if p.Labels != nil {
+		opt.Labels = (*gitlab.LabelOptions)(&p.Labels)
+	}
+	if p.AssigneeIDs != nil {
+		opt.AssigneeIDs = &p.AssigneeIDs
+	}
+
+	return opt
+}
+
+// GenerateUpdateIssueOptions generates issue update options
+func GenerateUpdateIssueOptions(p *v1alpha1.IssueParameters) *gitlab.UpdateIssueOptions {
+	opt := &gitlab.UpdateIssueOptions{
+		Title:        &p.Title,
+		Description:  p.Description,
+		Confidential: p.Confidential,
+		MilestoneID:  p.MilestoneID,
+	}
+
+	if p.Labels != nil {
+		opt.Labels = (*gitlab.LabelOptions)(&p.Labels)
+	}
+	if p.AssigneeIDs != nil {
+		opt.AssigneeIDs = &p.AssigneeIDs
+	}
+
+	return opt
+}
+
+// GenerateIssueObservation is used to produce v1alpha1.IssueObservation from
+// gitlab.Issue.
+func GenerateIssueObservation(i *gitlab.Issue) v1alpha1.IssueObservation {
+	if i == nil {
+		return v1alpha1.IssueObservation{}
+	}
+
+	return v1alpha1.IssueObservation{
+		ID:        i.ID,
+		IID:       i.IID,
+		State:     i.State,
+		WebURL:    i.WebURL,
+		CreatedAt: clients.TimeToMetaTime(i.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(i.UpdatedAt),
+		ClosedAt:  clients.TimeToMetaTime(i.ClosedAt),
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+// ProjectPagesSettings represents the Pages settings of a project. The
+// go-gitlab client only supports unpublishing Pages.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/pages.html
+type ProjectPagesSettings struct {
+	URL                   string `json:"url"`
+	IsUniqueDomainEnabled bool   `json:"is_unique_domain_enabled"`
+	ForceHTTPS            bool   `json:"force_https"`
+}
+
+// UpdateProjectPagesSettingsOptions represents the available
+// UpdateProjectPagesSettings() options.
+type UpdateProjectPagesSettingsOptions struct {
+	PagesUniqueDomainEnabled *bool `url:"pages_unique_domain_enabled,omitempty" json:"pages_unique_domain_enabled,omitempty"`
+	PagesHTTPSOnly           *bool `url:"pages_https_only,omitempty" json:"pages_https_only,omitempty"`
+}
+
+// PagesSettingsClient defines Gitlab project Pages settings operations
+type PagesSettingsClient interface {
+	GetProjectPagesSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*ProjectPagesSettings, *gitlab.Response, error)
+	UpdateProjectPagesSettings(pid interface{}, opt *UpdateProjectPagesSettingsOptions, options ...gitlab.RequestOptionFunc) (*ProjectPagesSettings, *gitlab.Response, error)
+}
+
+type pagesSettingsService struct {
+	client *gitlab.Client
+}
+
+func pagesSettingsPath(pid interface{}) string {
+	return fmt.Sprintf("projects/%s/pages", gitlab.PathEscape(fmt.Sprint(pid)))
+}
+
+// GetProjectPagesSettings gets the Pages settings of a project.
+func (s *pagesSettingsService) GetProjectPagesSettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*ProjectPagesSettings, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, pagesSettingsPath(pid), nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(ProjectPagesSettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return settings, resp, nil
+}
+
+// UpdateProjectPagesSettings updates the Pages settings of a project.
+func (s *pagesSettingsService) UpdateProjectPagesSettings(pid interface{}, opt *UpdateProjectPagesSettingsOptions, options ...gitlab.RequestOptionFunc) (*ProjectPagesSettings, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodPatch, pagesSettingsPath(pid), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(ProjectPagesSettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return settings, resp, nil
+}
+
+// HasPagesSettings returns true if any of the project Pages settings are
+// specified.
+func HasPagesSettings(p *v1alpha1.ProjectParameters) bool {
+	return p.PagesUniqueDomainEnabled != nil || p.PagesHTTPSOnly != nil
+}
+
+// IsPagesAvailable returns true if the observed project may serve Pages, i.e.
+// its Pages access level is known and not disabled.
+func IsPagesAvailable(p *gitlab.Project) bool {
+	return p != nil && p.PagesAccessLevel != "" && p.PagesAccessLevel != gitlab.DisabledAccessControl
+}
+
+// GenerateUpdateProjectPagesSettingsOptions generates project Pages settings
+// update options.
+func GenerateUpdateProjectPagesSettingsOptions(p *v1alpha1.ProjectParameters) *UpdateProjectPagesSettingsOptions {
+	return &UpdateProjectPagesSettingsOptions{
+		PagesUniqueDomainEnabled: p.PagesUniqueDomainEnabled,
+		PagesHTTPSOnly:           p.PagesHTTPSOnly,
+	}
+}
+
+// LateInitializePagesSettings fills the unspecified Pages settings from the
+// observed ones.
+func LateInitializePagesSettings(p *v1alpha1.ProjectParameters, s *ProjectPagesSettings) {
+	if s == nil {
+		return
+	}
+	if p.PagesUniqueDomainEnabled == nil {
+		p.PagesUniqueDomainEnabled = &s.IsUniqueDomainEnabled
+	}
+	if p.PagesHTTPSOnly == nil {
+		p.PagesHTTPSOnly = &s.ForceHTTPS
+	}
+}
+
+// IsPagesSettingsUpToDate checks whether the specified Pages settings match
+// the observed ones. Unspecified settings are ignored.
+func IsPagesSettingsUpToDate(p *v1alpha1.ProjectParameters, s *ProjectPagesSettings) bool {
+	if s == nil {
+		return !HasPagesSettings(p)
+	}
+	if p.PagesUniqueDomainEnabled != nil && *p.PagesUniqueDomainEnabled != s.IsUniqueDomainEnabled {
+		return false
+	}
+	if p.PagesHTTPSOnly != nil && *p.PagesHTTPSOnly != s.ForceHTTPS {
+		return false
+	}
+	return true
+}
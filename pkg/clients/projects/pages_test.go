@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsPagesSettingsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ProjectParameters
+		s    *ProjectPagesSettings
+		want bool
+	}{
+		"NothingSpecified": {
+			p:    &v1alpha1.ProjectParameters{},
+			want: true,
+		},
+		"UpToDate": {
+			p:    &v1alpha1.ProjectParameters{PagesHTTPSOnly: gitlab.Ptr(true)},
+			s:    &ProjectPagesSettings{ForceHTTPS: true, IsUniqueDomainEnabled: true},
+			want: true,
+		},
+		"HTTPSOnlyChanged": {
+			p:    &v1alpha1.ProjectParameters{PagesHTTPSOnly: gitlab.Ptr(true)},
+			s:    &ProjectPagesSettings{},
+			want: false,
+		},
+		"UniqueDomainChanged": {
+			p:    &v1alpha1.ProjectParameters{PagesUniqueDomainEnabled: gitlab.Ptr(false)},
+			s:    &ProjectPagesSettings{IsUniqueDomainEnabled: true},
+			want: false,
+		},
+		"NoSettingsObserved": {
+			p:    &v1alpha1.ProjectParameters{PagesUniqueDomainEnabled: gitlab.Ptr(true)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsPagesSettingsUpToDate(tc.p, tc.s)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializePagesSettings(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ProjectParameters
+		s    *ProjectPagesSettings
+		want *v1alpha1.ProjectParameters
+	}{
+		"NoSettingsObserved": {
+			p:    &v1alpha1.ProjectParameters{},
+			want: &v1alpha1.ProjectParameters{},
+		},
+		"FillsUnspecified": {
+			p: &v1alpha1.ProjectParameters{PagesHTTPSOnly: gitlab.Ptr(false)},
+			s: &ProjectPagesSettings{ForceHTTPS: true, IsUniqueDomainEnabled: true},
+			want: &v1alpha1.ProjectParameters{
+				PagesHTTPSOnly:           gitlab.Ptr(false),
+				PagesUniqueDomainEnabled: gitlab.Ptr(true),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializePagesSettings(tc.p, tc.s)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -37,12 +37,29 @@ type Client interface {
 	CreateProject(opt *gitlab.CreateProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	EditProject(pid interface{}, opt *gitlab.EditProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
 	DeleteProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	StartHousekeepingProject(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	CreateCommit(pid interface{}, opt *gitlab.CreateCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error)
+
+	SecuritySettingsClient
+	PagesSettingsClient
+}
+
+type projectClient struct {
+	*gitlab.ProjectsService
+	*gitlab.CommitsService
+	*securitySettingsService
+	*pagesSettingsService
 }
 
 // NewProjectClient returns a new Gitlab Project service
 func NewProjectClient(cfg clients.Config) Client {
 	git := clients.NewClient(cfg)
-	return git.Projects
+	return &projectClient{
+		ProjectsService:         git.Projects,
+		CommitsService:          git.Commits,
+		securitySettingsService: &securitySettingsService{client: git},
+		pagesSettingsService:    &pagesSettingsService{client: git},
+	}
 }
 
 // IsErrorProjectNotFound helper function to test for errProjectNotFound error.
@@ -77,7 +94,8 @@ func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { //no
 		SnippetsEnabled:      prj.SnippetsEnabled,
 		CreatorID:            prj.CreatorID,
 		ImportStatus:         prj.ImportStatus,
-		ImportError:          prj.ImportError,
+		ImportError:          clients.RedactURLs(prj.ImportError),
+		ImportURL:            clients.RedactURL(prj.ImportURL),
 		Archived:             prj.Archived,
 		ForksCount:           prj.ForksCount,
 		StarCount:            prj.StarCount,
@@ -95,7 +113,7 @@ func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { //no
 			NameRegexDelete: prj.ContainerExpirationPolicy.NameRegexDelete,
 			NameRegexKeep:   prj.ContainerExpirationPolicy.NameRegexKeep,
 			Enabled:         prj.ContainerExpirationPolicy.Enabled,
-			NextRunAt:       &metav1.Time{Time: *prj.ContainerExpirationPolicy.NextRunAt},
+			NextRunAt:       clients.TimeToMetaTime(prj.ContainerExpirationPolicy.NextRunAt),
 		}
 	}
 
@@ -139,6 +157,7 @@ func GenerateObservation(prj *gitlab.Project) v1alpha1.ProjectObservation { //no
 				LfsObjectsSize:   prj.Statistics.LFSObjectsSize,
 				JobArtifactsSize: prj.Statistics.JobArtifactsSize,
 			},
+			CommitCount: int(prj.Statistics.CommitCount),
 		}
 	}
 
@@ -301,6 +320,11 @@ func GenerateCreateProjectOptions(name string, p *v1alpha1.ProjectParameters) *g
 		OnlyAllowMergeIfPipelineSucceeds:    p.OnlyAllowMergeIfPipelineSucceeds,
 		OnlyAllowMergeIfAllDiscussionsAreResolved: p.OnlyAllowMergeIfAllDiscussionsAreResolved,
 		MergeMethod:                              clients.MergeMethodV1alpha1ToGitlab(p.MergeMethod),
+		MergePipelinesEnabled:                    p.MergePipelinesEnabled,
+		MergeTrainsEnabled:                       p.MergeTrainsEnabled,
+		SquashOption:                             clients.SquashOptionV1alpha1ToGitlab(p.SquashOption),
+		MergeCommitTemplate:                      p.MergeCommitTemplate,
+		SquashCommitTemplate:                     p.SquashCommitTemplate,
 		RemoveSourceBranchAfterMerge:             p.RemoveSourceBranchAfterMerge,
 		LFSEnabled:                               p.LFSEnabled,
 		RequestAccessEnabled:                     p.RequestAccessEnabled,
@@ -365,6 +389,11 @@ func GenerateEditProjectOptions(name string, p *v1alpha1.ProjectParameters) *git
 		OnlyAllowMergeIfPipelineSucceeds:    p.OnlyAllowMergeIfPipelineSucceeds,
 		OnlyAllowMergeIfAllDiscussionsAreResolved: p.OnlyAllowMergeIfAllDiscussionsAreResolved,
 		MergeMethod:                              clients.MergeMethodV1alpha1ToGitlab(p.MergeMethod),
+		MergePipelinesEnabled:                    p.MergePipelinesEnabled,
+		MergeTrainsEnabled:                       p.MergeTrainsEnabled,
+		SquashOption:                             clients.SquashOptionV1alpha1ToGitlab(p.SquashOption),
+		MergeCommitTemplate:                      p.MergeCommitTemplate,
+		SquashCommitTemplate:                     p.SquashCommitTemplate,
 		RemoveSourceBranchAfterMerge:             p.RemoveSourceBranchAfterMerge,
 		LFSEnabled:                               p.LFSEnabled,
 		RequestAccessEnabled:                     p.RequestAccessEnabled,
@@ -394,3 +423,22 @@ func GenerateEditProjectOptions(name string, p *v1alpha1.ProjectParameters) *git
 	}
 	return o
 }
+
+// IsStatisticsRefreshDue returns true if the project statistics should be
+// fetched. Without a refresh interval they are only needed, on every poll,
+// to check a repository size threshold.
+func IsStatisticsRefreshDue(p *v1alpha1.ProjectParameters, refreshedAt *metav1.Time, now time.Time) bool {
+	if p.StatisticsRefreshInterval == nil {
+		return p.RepositorySizeThreshold != nil
+	}
+	return refreshedAt == nil || now.Sub(refreshedAt.Time) >= p.StatisticsRefreshInterval.Duration
+}
+
+// IsRepositorySizeAboveThreshold returns true if the observed repository
+// size exceeds the threshold given in the spec.
+func IsRepositorySizeAboveThreshold(p *v1alpha1.ProjectParameters, s *v1alpha1.ProjectStatistics) bool {
+	if p.RepositorySizeThreshold == nil || s == nil {
+		return false
+	}
+	return s.RepositorySize > p.RepositorySizeThreshold.Value()
+}
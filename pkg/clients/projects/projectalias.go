@@ -0,0 +1,126 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ProjectAlias represents a Gitlab project alias.
+// The go-gitlab client does not model this API yet.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_aliases.html
+type ProjectAlias struct {
+	ID        int    `json:"id"`
+	ProjectID int    `json:"project_id"`
+	Name      string `json:"name"`
+}
+
+// CreateProjectAliasOptions represents the available CreateProjectAlias()
+// options.
+type CreateProjectAliasOptions struct {
+	ProjectID *string `url:"project_id,omitempty" json:"project_id,omitempty"`
+	Name      *string `url:"name,omitempty" json:"name,omitempty"`
+}
+
+// ProjectAliasClient defines Gitlab project alias operations
+type ProjectAliasClient interface {
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	GetProjectAlias(name string, options ...gitlab.RequestOptionFunc) (*ProjectAlias, *gitlab.Response, error)
+	CreateProjectAlias(opt *CreateProjectAliasOptions, options ...gitlab.RequestOptionFunc) (*ProjectAlias, *gitlab.Response, error)
+	DeleteProjectAlias(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type projectAliasService struct {
+	*gitlab.ProjectsService
+	client *gitlab.Client
+}
+
+// NewProjectAliasClient returns a new Gitlab project alias service
+func NewProjectAliasClient(cfg clients.Config) ProjectAliasClient {
+	git := clients.NewClient(cfg)
+	return &projectAliasService{ProjectsService: git.Projects, client: git}
+}
+
+func projectAliasPath(name string) string {
+	return fmt.Sprintf("project_aliases/%s", gitlab.PathEscape(name))
+}
+
+// GetProjectAlias gets a single project alias.
+func (s *projectAliasService) GetProjectAlias(name string, options ...gitlab.RequestOptionFunc) (*ProjectAlias, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, projectAliasPath(name), nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(ProjectAlias)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+	return a, resp, nil
+}
+
+// CreateProjectAlias creates a project alias.
+func (s *projectAliasService) CreateProjectAlias(opt *CreateProjectAliasOptions, options ...gitlab.RequestOptionFunc) (*ProjectAlias, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "project_aliases", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(ProjectAlias)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+	return a, resp, nil
+}
+
+// DeleteProjectAlias deletes a project alias.
+func (s *projectAliasService) DeleteProjectAlias(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, projectAliasPath(name), nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(req, nil)
+}
+
+// GenerateCreateProjectAliasOptions generates project alias creation options
+func GenerateCreateProjectAliasOptions(p *v1alpha1.ProjectAliasParameters) *CreateProjectAliasOptions {
+	return &CreateProjectAliasOptions{
+		ProjectID: p.ProjectID,
+		Name:      &p.Alias,
+	}
+}
+
+// GenerateProjectAliasObservation is used to produce
+// v1alpha1.ProjectAliasObservation from ProjectAlias.
+func GenerateProjectAliasObservation(a *ProjectAlias) v1alpha1.ProjectAliasObservation {
+	if a == nil {
+		return v1alpha1.ProjectAliasObservation{}
+	}
+	return v1alpha1.ProjectAliasObservation{
+		ID:        a.ID,
+		ProjectID: a.ProjectID,
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	errResolveProjectPath = "cannot resolve Gitlab project path"
+)
+
+// ProjectGetter gets a Gitlab project by ID or URL-encoded path.
+type ProjectGetter interface {
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+}
+
+// projectCache is shared by the project getters of all controllers.
+var projectCache = clients.NewLookupCache[*gitlab.Project](clients.DefaultLookupCacheTTL)
+
+// NewProjectGetter returns a new Gitlab project getter. Projects are cached for
+// a short time, as many resources of a project look it up on every reconcile.
+func NewProjectGetter(cfg clients.Config) ProjectGetter {
+	git := clients.NewClient(cfg)
+	return &cachedProjectGetter{getter: git.Projects, cache: projectCache, cfg: cfg}
+}
+
+type cachedProjectGetter struct {
+	getter ProjectGetter
+	cache  *clients.LookupCache[*gitlab.Project]
+	cfg    clients.Config
+}
+
+// GetProject gets a project, from the cache if it was recently looked up.
+// Requests with options are never cached.
+func (c *cachedProjectGetter) GetProject(id interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	if opt != nil {
+		return c.getter.GetProject(id, opt, options...)
+	}
+	var res *gitlab.Response
+	prj, err := c.cache.Get(clients.LookupCacheKey(c.cfg, "project", fmt.Sprint(id)), func() (*gitlab.Project, error) {
+		prj, r, err := c.getter.GetProject(id, nil, options...)
+		res = r
+		return prj, err
+	})
+	return prj, res, err
+}
+
+// ResolveProjectPath returns the ID of the project with the given full path,
+// e.g. my-group/my-project. It allows project scoped resources to reference
+// projects that are not managed by Crossplane.
+func ResolveProjectPath(ctx context.Context, c ProjectGetter, path string) (int, error) {
+	prj, _, err := c.GetProject(path, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, errResolveProjectPath)
+	}
+	return prj.ID, nil
+}
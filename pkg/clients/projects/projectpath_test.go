@@ -0,0 +1,108 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+type mockProjectGetter func(pid interface{}) (*gitlab.Project, *gitlab.Response, error)
+
+func (m mockProjectGetter) GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+	return m(pid)
+}
+
+func TestResolveProjectPath(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		id  int
+		err error
+	}
+	cases := map[string]struct {
+		getter mockProjectGetter
+		path   string
+		want   want
+	}{
+		"Resolved": {
+			getter: func(pid interface{}) (*gitlab.Project, *gitlab.Response, error) {
+				if pid != "my-group/my-project" {
+					return nil, &gitlab.Response{}, errBoom
+				}
+				return &gitlab.Project{ID: 42}, &gitlab.Response{}, nil
+			},
+			path: "my-group/my-project",
+			want: want{id: 42},
+		},
+		"Failed": {
+			getter: func(pid interface{}) (*gitlab.Project, *gitlab.Response, error) {
+				return nil, &gitlab.Response{}, errBoom
+			},
+			path: "my-group/my-project",
+			want: want{err: errors.Wrap(errBoom, errResolveProjectPath)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := ResolveProjectPath(context.Background(), tc.getter, tc.path)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCachedProjectGetter(t *testing.T) {
+	calls := 0
+	getter := mockProjectGetter(func(pid interface{}) (*gitlab.Project, *gitlab.Response, error) {
+		calls++
+		return &gitlab.Project{ID: 42}, &gitlab.Response{}, nil
+	})
+	cfg := clients.Config{BaseURL: "https://gitlab.example.com", Token: "token"}
+	c := &cachedProjectGetter{getter: getter, cache: clients.NewLookupCache[*gitlab.Project](clients.DefaultLookupCacheTTL), cfg: cfg}
+
+	for i := 0; i < 3; i++ {
+		id, err := ResolveProjectPath(context.Background(), c, "my-group/my-project")
+		if err != nil {
+			t.Fatalf("ResolveProjectPath(...): unexpected error: %v", err)
+		}
+		if id != 42 {
+			t.Errorf("ResolveProjectPath(...): want 42, got %d", id)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("GetProject(...): want 1 call, got %d", calls)
+	}
+
+	if _, _, err := c.GetProject("my-group/my-project", &gitlab.GetProjectOptions{}); err != nil {
+		t.Fatalf("GetProject(...): unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("GetProject(...) with options: want uncached call, got %d calls", calls)
+	}
+}
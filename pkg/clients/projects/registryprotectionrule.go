@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// RegistryProtectionRule represents a container registry protection rule.
+// The go-gitlab client does not model this API yet.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/container_repository_protection_rules.html
+type RegistryProtectionRule struct {
+	ID                          int    `json:"id"`
+	ProjectID                   int    `json:"project_id"`
+	RepositoryPathPattern       string `json:"repository_path_pattern"`
+	MinimumAccessLevelForPush   string `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete string `json:"minimum_access_level_for_delete"`
+}
+
+// RegistryProtectionRuleOptions represents the available
+// CreateRegistryProtectionRule() and UpdateRegistryProtectionRule() options.
+type RegistryProtectionRuleOptions struct {
+	RepositoryPathPattern       *string `url:"repository_path_pattern,omitempty" json:"repository_path_pattern,omitempty"`
+	MinimumAccessLevelForPush   *string `url:"minimum_access_level_for_push,omitempty" json:"minimum_access_level_for_push,omitempty"`
+	MinimumAccessLevelForDelete *string `url:"minimum_access_level_for_delete,omitempty" json:"minimum_access_level_for_delete,omitempty"`
+}
+
+// RegistryProtectionRuleClient defines Gitlab container registry protection
+// rule operations
+type RegistryProtectionRuleClient interface {
+	GetRegistryProtectionRule(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*RegistryProtectionRule, *gitlab.Response, error)
+	CreateRegistryProtectionRule(pid interface{}, opt *RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*RegistryProtectionRule, *gitlab.Response, error)
+	UpdateRegistryProtectionRule(pid interface{}, rule int, opt *RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*RegistryProtectionRule, *gitlab.Response, error)
+	DeleteRegistryProtectionRule(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type registryProtectionRuleService struct {
+	client  *gitlab.Client
+	perPage int
+}
+
+// NewRegistryProtectionRuleClient returns a new Gitlab container registry
+// protection rule service
+func NewRegistryProtectionRuleClient(cfg clients.Config) RegistryProtectionRuleClient {
+	git := clients.NewClient(cfg)
+	return &registryProtectionRuleService{client: git, perPage: cfg.PerPage}
+}
+
+func registryProtectionRulesPath(pid interface{}) string {
+	return fmt.Sprintf("projects/%s/registry/protection/repository/rules", gitlab.PathEscape(fmt.Sprint(pid)))
+}
+
+// GetRegistryProtectionRule gets a single container registry protection rule,
+// or nil if it does not exist. The API only supports listing the rules of a
+// project, so the rule is looked up in all pages of that list.
+func (s *registryProtectionRuleService) GetRegistryProtectionRule(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*RegistryProtectionRule, *gitlab.Response, error) {
+	rules, resp, err := clients.ListAll(s.perPage, func(opt gitlab.ListOptions) ([]*RegistryProtectionRule, *gitlab.Response, error) {
+		req, err := s.client.NewRequest(http.MethodGet, registryProtectionRulesPath(pid), &opt, options)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var rules []*RegistryProtectionRule
+		resp, err := s.client.Do(req, &rules)
+		return rules, resp, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, r := range rules {
+		if r.ID == rule {
+			return r, resp, nil
+		}
+	}
+	return nil, resp, nil
+}
+
+// CreateRegistryProtectionRule creates a container registry protection rule.
+func (s *registryProtectionRuleService) CreateRegistryProtectionRule(pid interface{}, opt *RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*RegistryProtectionRule, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, registryProtectionRulesPath(pid), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RegistryProtectionRule)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// UpdateRegistryProtectionRule updates a container registry protection rule.
+func (s *registryProtectionRuleService) UpdateRegistryProtectionRule(pid interface{}, rule int, opt *RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*RegistryProtectionRule, *gitlab.Response, error) {
+	u := fmt.Sprintf("%s/%d", registryProtectionRulesPath(pid), rule)
+	req, err := s.client.NewRequest(http.MethodPatch, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RegistryProtectionRule)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+	return r, resp, nil
+}
+
+// DeleteRegistryProtectionRule deletes a container registry protection rule.
+func (s *registryProtectionRuleService) DeleteRegistryProtectionRule(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	u := fmt.Sprintf("%s/%d", registryProtectionRulesPath(pid), rule)
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(req, nil)
+}
+
+// GenerateRegistryProtectionRuleOptions generates container registry
+// protection rule creation and update options
+func GenerateRegistryProtectionRuleOptions(p *v1alpha1.RegistryProtectionRuleParameters) *RegistryProtectionRuleOptions {
+	opt := &RegistryProtectionRuleOptions{
+		RepositoryPathPattern: &p.RepositoryPathPattern,
+	}
+	if p.MinimumAccessLevelForPush != nil {
+		opt.MinimumAccessLevelForPush = gitlab.Ptr(string(*p.MinimumAccessLevelForPush))
+	}
+	if p.MinimumAccessLevelForDelete != nil {
+		opt.MinimumAccessLevelForDelete = gitlab.Ptr(string(*p.MinimumAccessLevelForDelete))
+	}
+	return opt
+}
+
+// GenerateRegistryProtectionRuleObservation is used to produce
+// v1alpha1.RegistryProtectionRuleObservation from RegistryProtectionRule.
+func GenerateRegistryProtectionRuleObservation(r *RegistryProtectionRule) v1alpha1.RegistryProtectionRuleObservation {
+	if r == nil {
+		return v1alpha1.RegistryProtectionRuleObservation{}
+	}
+	return v1alpha1.RegistryProtectionRuleObservation{
+		ID:        r.ID,
+		ProjectID: r.ProjectID,
+	}
+}
+
+// IsRegistryProtectionRuleUpToDate checks whether the observed rule matches
+// the desired state. Unspecified access levels are ignored.
+func IsRegistryProtectionRuleUpToDate(p *v1alpha1.RegistryProtectionRuleParameters, r *RegistryProtectionRule) bool {
+	if p.RepositoryPathPattern != r.RepositoryPathPattern {
+		return false
+	}
+	if p.MinimumAccessLevelForPush != nil && string(*p.MinimumAccessLevelForPush) != r.MinimumAccessLevelForPush {
+		return false
+	}
+	if p.MinimumAccessLevelForDelete != nil && string(*p.MinimumAccessLevelForDelete) != r.MinimumAccessLevelForDelete {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+const (
+	getSecurityPolicyProjectQuery = `query($fullPath: ID!) {
+  project(fullPath: $fullPath) {
+    securityPolicyProject {
+      id
+      fullPath
+    }
+  }
+}`
+
+	assignSecurityPolicyProjectMutation = `mutation($fullPath: String!, $securityPolicyProjectId: ProjectID!) {
+  securityPolicyProjectAssign(input: {fullPath: $fullPath, securityPolicyProjectId: $securityPolicyProjectId}) {
+    errors
+  }
+}`
+
+	unassignSecurityPolicyProjectMutation = `mutation($fullPath: String!) {
+  securityPolicyProjectUnassign(input: {fullPath: $fullPath}) {
+    errors
+  }
+}`
+)
+
+// SecurityPolicyProject represents the security policy project linked to a
+// project.
+type SecurityPolicyProject struct {
+	ID       int
+	FullPath string
+}
+
+// SecurityPolicyProjectLinkClient defines Gitlab security policy project
+// operations. Linking is only available through the GraphQL API.
+type SecurityPolicyProjectLinkClient interface {
+	GetProject(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error)
+	GetSecurityPolicyProject(fullPath string, options ...gitlab.RequestOptionFunc) (*SecurityPolicyProject, *gitlab.Response, error)
+	AssignSecurityPolicyProject(fullPath string, policyProjectID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+	UnassignSecurityPolicyProject(fullPath string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+type securityPolicyProjectLinkClient struct {
+	*gitlab.ProjectsService
+	client *gitlab.Client
+}
+
+// NewSecurityPolicyProjectLinkClient returns a new Gitlab security policy
+// project link client
+func NewSecurityPolicyProjectLinkClient(cfg clients.Config) SecurityPolicyProjectLinkClient {
+	git := clients.NewClient(cfg)
+	return &securityPolicyProjectLinkClient{ProjectsService: git.Projects, client: git}
+}
+
+// GetSecurityPolicyProject returns the security policy project linked to the
+// project with the given full path, or nil if there is none.
+func (c *securityPolicyProjectLinkClient) GetSecurityPolicyProject(fullPath string, options ...gitlab.RequestOptionFunc) (*SecurityPolicyProject, *gitlab.Response, error) {
+	var out struct {
+		Project *struct {
+			SecurityPolicyProject *struct {
+				ID       string `json:"id"`
+				FullPath string `json:"fullPath"`
+			} `json:"securityPolicyProject"`
+		} `json:"project"`
+	}
+	req := clients.GraphQLRequest{
+		Query:     getSecurityPolicyProjectQuery,
+		Variables: map[string]interface{}{"fullPath": fullPath},
+	}
+	resp, err := clients.DoGraphQL(c.client, req, &out, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if out.Project == nil || out.Project.SecurityPolicyProject == nil {
+		return nil, resp, nil
+	}
+
+	id, err := clients.ParseGraphQLGID(out.Project.SecurityPolicyProject.ID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &SecurityPolicyProject{ID: id, FullPath: out.Project.SecurityPolicyProject.FullPath}, resp, nil
+}
+
+// AssignSecurityPolicyProject links the security policy project to the
+// project with the given full path.
+func (c *securityPolicyProjectLinkClient) AssignSecurityPolicyProject(fullPath string, policyProjectID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	var out struct {
+		Payload mutationPayload `json:"securityPolicyProjectAssign"`
+	}
+	req := clients.GraphQLRequest{
+		Query: assignSecurityPolicyProjectMutation,
+		Variables: map[string]interface{}{
+			"fullPath":                fullPath,
+			"securityPolicyProjectId": clients.GraphQLProjectGID(policyProjectID),
+		},
+	}
+	resp, err := clients.DoGraphQL(c.client, req, &out, options...)
+	if err != nil {
+		return resp, err
+	}
+	return resp, out.Payload.err()
+}
+
+// UnassignSecurityPolicyProject removes the link to the security policy
+// project from the project with the given full path.
+func (c *securityPolicyProjectLinkClient) UnassignSecurityPolicyProject(fullPath string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	var out struct {
+		Payload mutationPayload `json:"securityPolicyProjectUnassign"`
+	}
+	req := clients.GraphQLRequest{
+		Query:     unassignSecurityPolicyProjectMutation,
+		Variables: map[string]interface{}{"fullPath": fullPath},
+	}
+	resp, err := clients.DoGraphQL(c.client, req, &out, options...)
+	if err != nil {
+		return resp, err
+	}
+	return resp, out.Payload.err()
+}
+
+// mutationPayload holds the errors GitLab returns from a GraphQL mutation
+// that was executed but could not be applied.
+type mutationPayload struct {
+	Errors []string `json:"errors"`
+}
+
+func (p mutationPayload) err() error {
+	if len(p.Errors) == 0 {
+		return nil
+	}
+	return errors.New(p.Errors[0])
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+// ProjectSecuritySettings represents the security settings of a project.
+// The go-gitlab client does not model this API yet.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_security_settings.html
+type ProjectSecuritySettings struct {
+	ProjectID                        int   `json:"project_id"`
+	SecretPushProtectionEnabled      *bool `json:"secret_push_protection_enabled"`
+	PreReceiveSecretDetectionEnabled *bool `json:"pre_receive_secret_detection_enabled"`
+}
+
+// UpdateProjectSecuritySettingsOptions represents the available
+// UpdateProjectSecuritySettings() options.
+type UpdateProjectSecuritySettingsOptions struct {
+	SecretPushProtectionEnabled      *bool `url:"secret_push_protection_enabled,omitempty" json:"secret_push_protection_enabled,omitempty"`
+	PreReceiveSecretDetectionEnabled *bool `url:"pre_receive_secret_detection_enabled,omitempty" json:"pre_receive_secret_detection_enabled,omitempty"`
+}
+
+// SecuritySettingsClient defines Gitlab project security settings operations
+type SecuritySettingsClient interface {
+	GetProjectSecuritySettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*ProjectSecuritySettings, *gitlab.Response, error)
+	UpdateProjectSecuritySettings(pid interface{}, opt *UpdateProjectSecuritySettingsOptions, options ...gitlab.RequestOptionFunc) (*ProjectSecuritySettings, *gitlab.Response, error)
+}
+
+type securitySettingsService struct {
+	client *gitlab.Client
+}
+
+func securitySettingsPath(pid interface{}) string {
+	return fmt.Sprintf("projects/%s/security_settings", gitlab.PathEscape(fmt.Sprint(pid)))
+}
+
+// GetProjectSecuritySettings gets the security settings of a project.
+func (s *securitySettingsService) GetProjectSecuritySettings(pid interface{}, options ...gitlab.RequestOptionFunc) (*ProjectSecuritySettings, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, securitySettingsPath(pid), nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(ProjectSecuritySettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return settings, resp, nil
+}
+
+// UpdateProjectSecuritySettings updates the security settings of a project.
+func (s *securitySettingsService) UpdateProjectSecuritySettings(pid interface{}, opt *UpdateProjectSecuritySettingsOptions, options ...gitlab.RequestOptionFunc) (*ProjectSecuritySettings, *gitlab.Response, error) {
+	req, err := s.client.NewRequest(http.MethodPut, securitySettingsPath(pid), opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(ProjectSecuritySettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return settings, resp, nil
+}
+
+// HasSecuritySettings returns true if any of the project security settings
+// are specified.
+func HasSecuritySettings(p *v1alpha1.ProjectParameters) bool {
+	return p.SecretPushProtectionEnabled != nil || p.PreReceiveSecretDetectionEnabled != nil
+}
+
+// GenerateUpdateProjectSecuritySettingsOptions generates project security
+// settings update options.
+func GenerateUpdateProjectSecuritySettingsOptions(p *v1alpha1.ProjectParameters) *UpdateProjectSecuritySettingsOptions {
+	return &UpdateProjectSecuritySettingsOptions{
+		SecretPushProtectionEnabled:      p.SecretPushProtectionEnabled,
+		PreReceiveSecretDetectionEnabled: p.PreReceiveSecretDetectionEnabled,
+	}
+}
+
+// IsSecuritySettingsUpToDate checks whether the specified security settings
+// match the observed ones. Unspecified settings are ignored.
+func IsSecuritySettingsUpToDate(p *v1alpha1.ProjectParameters, s *ProjectSecuritySettings) bool {
+	if s == nil {
+		return !HasSecuritySettings(p)
+	}
+	if p.SecretPushProtectionEnabled != nil && (s.SecretPushProtectionEnabled == nil || *p.SecretPushProtectionEnabled != *s.SecretPushProtectionEnabled) {
+		return false
+	}
+	if p.PreReceiveSecretDetectionEnabled != nil && (s.PreReceiveSecretDetectionEnabled == nil || *p.PreReceiveSecretDetectionEnabled != *s.PreReceiveSecretDetectionEnabled) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+)
+
+func TestIsSecuritySettingsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ProjectParameters
+		s    *ProjectSecuritySettings
+		want bool
+	}{
+		"NothingSpecified": {
+			p:    &v1alpha1.ProjectParameters{},
+			want: true,
+		},
+		"UpToDate": {
+			p:    &v1alpha1.ProjectParameters{SecretPushProtectionEnabled: gitlab.Ptr(true)},
+			s:    &ProjectSecuritySettings{SecretPushProtectionEnabled: gitlab.Ptr(true), PreReceiveSecretDetectionEnabled: gitlab.Ptr(false)},
+			want: true,
+		},
+		"SecretPushProtectionChanged": {
+			p:    &v1alpha1.ProjectParameters{SecretPushProtectionEnabled: gitlab.Ptr(true)},
+			s:    &ProjectSecuritySettings{SecretPushProtectionEnabled: gitlab.Ptr(false)},
+			want: false,
+		},
+		"PreReceiveSecretDetectionNotReported": {
+			p:    &v1alpha1.ProjectParameters{PreReceiveSecretDetectionEnabled: gitlab.Ptr(true)},
+			s:    &ProjectSecuritySettings{},
+			want: false,
+		},
+		"NoSettingsObserved": {
+			p:    &v1alpha1.ProjectParameters{SecretPushProtectionEnabled: gitlab.Ptr(false)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsSecuritySettingsUpToDate(tc.p, tc.s)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
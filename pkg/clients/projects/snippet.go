@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projects
+
+import (
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// SnippetClient defines Gitlab project snippet service operations
+type SnippetClient interface {
+	GetSnippet(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	SnippetContent(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error)
+	CreateSnippet(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	UpdateSnippet(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error)
+	DeleteSnippet(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+// NewSnippetClient returns a new Gitlab project snippet service
+func NewSnippetClient(cfg clients.Config) SnippetClient {
+	git := clients.NewClient(cfg)
+	return git.ProjectSnippets
+}
+
+// GenerateCreateSnippetOptions generates project snippet creation options
+func GenerateCreateSnippetOptions(p *v1alpha1.SnippetParameters, content string) *gitlab.CreateProjectSnippetOptions {
+	return &gitlab.CreateProjectSnippetOptions{
+		Title:       &p.Title,
+		FileName:    &p.FileName,
+		Description: p.Description,
+		Content:     &content,
+		Visibility:  clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
+	}
+}
+
+// GenerateUpdateSnippetOptions generates project snippet update options
+func GenerateUpdateSnippetOptions(p *v1alpha1.SnippetParameters, content string) *gitlab.UpdateProjectSnippetOptions {
+	return &gitlab.UpdateProjectSnippetOptions{
+		Title:       &p.Title,
+		FileName:    &p.FileName,
+		Description: p.Description,
+		Content:     &content,
+		Visibility:  clients.VisibilityValueV1alpha1ToGitlab(p.Visibility),
+	}
+}
+
+// GenerateSnippetObservation is used to produce v1alpha1.SnippetObservation
+// from gitlab.Snippet.
+func GenerateSnippetObservation(s *gitlab.Snippet) v1alpha1.SnippetObservation {
+	if s == nil {
+		return v1alpha1.SnippetObservation{}
+	}
+
+	return v1alpha1.SnippetObservation{
+		ID:        s.ID,
+		WebURL:    s.WebURL,
+		RawURL:    s.RawURL,
+		CreatedAt: clients.TimeToMetaTime(s.CreatedAt),
+		UpdatedAt: clients.TimeToMetaTime(s.UpdatedAt),
+	}
+}
+
+// IsSnippetUpToDate checks whether the observed snippet and its content match
+// the desired state.
+func IsSnippetUpToDate(p *v1alpha1.SnippetParameters, s *gitlab.Snippet, content string, observedContent []byte) bool {
+	if p.Title != s.Title || p.FileName != s.FileName {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Description, s.Description) {
+		return false
+	}
+	if p.Visibility != nil && string(*p.Visibility) != s.Visibility {
+		return false
+	}
+	return content == string(observedContent)
+}
@@ -30,6 +30,10 @@ import (
 
 const (
 	errVariableNotFound = "404 Variable Not Found"
+
+	// defaultEnvironmentScope is the environment scope GitLab assigns to
+	// variables created without one.
+	defaultEnvironmentScope = "*"
 )
 
 // VariableClient defines Gitlab Variable service operations
@@ -97,6 +101,23 @@ func VariableToParameters(in gitlab.ProjectVariable) v1alpha1.VariableParameters
 	}
 }
 
+// GenerateVariableObservation is used to produce v1alpha1.VariableObservation
+// from gitlab.ProjectVariable.
+func GenerateVariableObservation(variable *gitlab.ProjectVariable) v1alpha1.VariableObservation {
+	if variable == nil {
+		return v1alpha1.VariableObservation{}
+	}
+
+	return v1alpha1.VariableObservation{
+		VariableType:     v1alpha1.VariableType(variable.VariableType),
+		Protected:        variable.Protected,
+		Masked:           variable.Masked,
+		Raw:              variable.Raw,
+		EnvironmentScope: variable.EnvironmentScope,
+		ValueChecksum:    clients.ValueChecksum(variable.Value),
+	}
+}
+
 // GenerateCreateVariableOptions generates project creation options
 func GenerateCreateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.CreateProjectVariableOptions {
 	variable := &gitlab.CreateProjectVariableOptions{
@@ -129,10 +150,6 @@ func GenerateUpdateVariableOptions(p *v1alpha1.VariableParameters) *gitlab.Updat
 
 // GenerateGetVariableOptions generates project get options
 func GenerateGetVariableOptions(p *v1alpha1.VariableParameters) *gitlab.GetProjectVariableOptions {
-	if p.EnvironmentScope == nil {
-		return nil
-	}
-
 	return &gitlab.GetProjectVariableOptions{
 		Filter: GenerateVariableFilter(p),
 	}
@@ -140,23 +157,24 @@ func GenerateGetVariableOptions(p *v1alpha1.VariableParameters) *gitlab.GetProje
 
 // GenerateRemoveVariableOptions generates project remove options.
 func GenerateRemoveVariableOptions(p *v1alpha1.VariableParameters) *gitlab.RemoveProjectVariableOptions {
-	if p.EnvironmentScope == nil {
-		return nil
-	}
-
 	return &gitlab.RemoveProjectVariableOptions{
 		Filter: GenerateVariableFilter(p),
 	}
 }
 
 // GenerateVariableFilter generates a variable filter that matches the variable parameters' environment scope.
+// The filter is always set, falling back to the default scope, so that a
+// variable whose key also exists in other environment scopes is not confused
+// with one of those. Existing resources without a scope are late initialized
+// with the scope observed through this filter.
 func GenerateVariableFilter(p *v1alpha1.VariableParameters) *gitlab.VariableFilter {
-	if p.EnvironmentScope == nil {
-		return nil
+	scope := defaultEnvironmentScope
+	if p.EnvironmentScope != nil {
+		scope = *p.EnvironmentScope
 	}
 
 	return &gitlab.VariableFilter{
-		EnvironmentScope: *p.EnvironmentScope,
+		EnvironmentScope: scope,
 	}
 }
 
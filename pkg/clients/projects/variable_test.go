@@ -198,6 +198,17 @@ func TestGenerateUpdateVariableOptions(t *testing.T) {
 				Filter:           &gitlab.VariableFilter{EnvironmentScope: variableEnvScope},
 			},
 		},
+		"NoScope": {
+			args: args{
+				parameters: &v1alpha1.VariableParameters{
+					Value: &variableValue,
+				},
+			},
+			want: &gitlab.UpdateProjectVariableOptions{
+				Value:  &variableValue,
+				Filter: &gitlab.VariableFilter{EnvironmentScope: "*"},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -301,7 +312,11 @@ func TestGenerateGetVariableOptions(t *testing.T) {
 			args: args{
 				p: &v1alpha1.VariableParameters{},
 			},
-			want: nil,
+			want: &gitlab.GetProjectVariableOptions{
+				Filter: &gitlab.VariableFilter{
+					EnvironmentScope: "*",
+				},
+			},
 		},
 	}
 	for name, tc := range tests {
@@ -338,7 +353,11 @@ func TestGenerateRemoveVariableOptions(t *testing.T) {
 			args: args{
 				p: &v1alpha1.VariableParameters{},
 			},
-			want: nil,
+			want: &gitlab.RemoveProjectVariableOptions{
+				Filter: &gitlab.VariableFilter{
+					EnvironmentScope: "*",
+				},
+			},
 		},
 	}
 	for name, tc := range tests {
@@ -350,3 +369,84 @@ func TestGenerateRemoveVariableOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateVariableFilter(t *testing.T) {
+	type args struct {
+		p *v1alpha1.VariableParameters
+	}
+	tests := map[string]struct {
+		args args
+		want *gitlab.VariableFilter
+	}{
+		"Scope": {
+			args: args{
+				p: &v1alpha1.VariableParameters{
+					EnvironmentScope: &variableEnvScope,
+				},
+			},
+			want: &gitlab.VariableFilter{
+				EnvironmentScope: variableEnvScope,
+			},
+		},
+		"NoScope": {
+			args: args{
+				p: &v1alpha1.VariableParameters{},
+			},
+			want: &gitlab.VariableFilter{
+				EnvironmentScope: "*",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateVariableFilter(tc.args.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateVariableObservation(t *testing.T) {
+	type args struct {
+		v *gitlab.ProjectVariable
+	}
+	tests := map[string]struct {
+		args args
+		want v1alpha1.VariableObservation
+	}{
+		"Nil": {
+			args: args{},
+			want: v1alpha1.VariableObservation{},
+		},
+		"Full": {
+			args: args{
+				v: &gitlab.ProjectVariable{
+					Key:              variableKey,
+					Value:            variableValue,
+					VariableType:     variableType,
+					Masked:           variableMasked,
+					Protected:        variableProtected,
+					EnvironmentScope: variableEnvScope,
+					Raw:              variableRaw,
+				},
+			},
+			want: v1alpha1.VariableObservation{
+				VariableType:     variableTypeLocal,
+				Masked:           variableMasked,
+				Protected:        variableProtected,
+				EnvironmentScope: variableEnvScope,
+				Raw:              variableRaw,
+				ValueChecksum:    "df7e70e5021544f4834bbee64a9e3789febc4be81470df629cad6ddb03320a5c",
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateVariableObservation(tc.args.v)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -41,8 +41,9 @@ func NewUserClient(cfg clients.Config) UserClient {
 
 // GetUserID gets Gitlab userID by Gitlab username
 func GetUserID(git UserClient, username string) (*int, error) {
-	userOptions := gitlab.ListUsersOptions{Username: &username}
-	userArr, _, err := git.ListUsers(&userOptions)
+	userArr, _, err := clients.ListAll(clients.DefaultPerPage, func(opt gitlab.ListOptions) ([]*gitlab.User, *gitlab.Response, error) {
+		return git.ListUsers(&gitlab.ListUsersOptions{ListOptions: opt, Username: &username})
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, errFetchFailed)
 	}
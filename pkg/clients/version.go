@@ -0,0 +1,176 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+const (
+	// DefaultVersionCacheTTL is the time a probed GitLab version is cached for.
+	DefaultVersionCacheTTL = time.Hour
+
+	errGetVersion   = "cannot get Gitlab version"
+	errParseVersion = "cannot parse Gitlab version %q"
+	errUnsupported  = "%s is not supported by Gitlab %s, it requires Gitlab %d.%d or later"
+)
+
+// VersionClient defines Gitlab Version service operations
+type VersionClient interface {
+	GetVersion(options ...gitlab.RequestOptionFunc) (*gitlab.Version, *gitlab.Response, error)
+}
+
+// NewVersionClient returns a new Gitlab Version service
+func NewVersionClient(cfg Config) VersionClient {
+	git := NewClient(cfg)
+	return git.Version
+}
+
+// Capability is a GitLab feature that is only available from a given
+// GitLab version on.
+type Capability struct {
+	Name  string
+	Major int
+	Minor int
+}
+
+// List of version dependent capabilities.
+var (
+	// CapabilityDefaultBranchProtectionDefaults is the
+	// default_branch_protection_defaults group setting.
+	CapabilityDefaultBranchProtectionDefaults = Capability{Name: "defaultBranchProtectionDefaults", Major: 17, Minor: 0}
+
+	// CapabilityEmailsEnabled is the emails_enabled group and project setting
+	// which replaces emails_disabled.
+	CapabilityEmailsEnabled = Capability{Name: "emailsEnabled", Major: 16, Minor: 5}
+)
+
+// ServerVersion is the version of a GitLab instance.
+type ServerVersion struct {
+	Major int
+	Minor int
+	Patch int
+
+	// Enterprise is true for GitLab Enterprise Edition instances.
+	Enterprise bool
+}
+
+// ParseServerVersion parses a version as returned by the GitLab version API,
+// e.g. 17.2.1-ee.
+func ParseServerVersion(v string) (*ServerVersion, error) {
+	version, suffix, _ := strings.Cut(v, "-")
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return nil, errors.Errorf(errParseVersion, v)
+	}
+
+	numbers := make([]int, 3)
+	for i := 0; i < len(parts) && i < len(numbers); i++ {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, errParseVersion, v)
+		}
+		numbers[i] = n
+	}
+
+	return &ServerVersion{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		Enterprise: suffix == "ee",
+	}, nil
+}
+
+// String returns the version in major.minor.patch format.
+func (v *ServerVersion) String() string {
+	if v == nil {
+		return "unknown"
+	}
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Supports returns true if the GitLab instance supports the given capability.
+// An unknown version is assumed to support every capability.
+func (v *ServerVersion) Supports(c Capability) bool {
+	if v == nil {
+		return true
+	}
+	if v.Major != c.Major {
+		return v.Major > c.Major
+	}
+	return v.Minor >= c.Minor
+}
+
+// UnsupportedError returns an error explaining that the capability is not
+// supported by the GitLab instance.
+func (v *ServerVersion) UnsupportedError(c Capability) error {
+	return errors.Errorf(errUnsupported, c.Name, v, c.Major, c.Minor)
+}
+
+type versionCacheEntry struct {
+	version   *ServerVersion
+	expiresAt time.Time
+}
+
+// VersionCache caches the GitLab version per ProviderConfig so that it is
+// not probed on every reconcile.
+type VersionCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]versionCacheEntry
+	now     func() time.Time
+}
+
+// NewVersionCache returns a VersionCache whose entries expire after ttl.
+func NewVersionCache(ttl time.Duration) *VersionCache {
+	return &VersionCache{
+		ttl:     ttl,
+		entries: map[string]versionCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached version of the GitLab instance configured by the
+// named ProviderConfig, probing it with the given client if needed.
+func (c *VersionCache) Get(providerConfig string, client VersionClient) (*ServerVersion, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[providerConfig]; ok && c.now().Before(e.expiresAt) {
+		return e.version, nil
+	}
+
+	v, _, err := client.GetVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, errGetVersion)
+	}
+
+	version, err := ParseServerVersion(v.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	c.entries[providerConfig] = versionCacheEntry{version: version, expiresAt: c.now().Add(c.ttl)}
+	return version, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+type mockVersionClient struct {
+	calls   int
+	version string
+	err     error
+}
+
+func (m *mockVersionClient) GetVersion(options ...gitlab.RequestOptionFunc) (*gitlab.Version, *gitlab.Response, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, nil, m.err
+	}
+	return &gitlab.Version{Version: m.version}, &gitlab.Response{}, nil
+}
+
+func TestParseServerVersion(t *testing.T) {
+	type want struct {
+		version *ServerVersion
+		err     error
+	}
+
+	cases := map[string]struct {
+		version string
+		want    want
+	}{
+		"EnterpriseEdition": {
+			version: "17.2.1-ee",
+			want:    want{version: &ServerVersion{Major: 17, Minor: 2, Patch: 1, Enterprise: true}},
+		},
+		"CommunityEdition": {
+			version: "16.11.0",
+			want:    want{version: &ServerVersion{Major: 16, Minor: 11}},
+		},
+		"PreRelease": {
+			version: "17.5.0-pre",
+			want:    want{version: &ServerVersion{Major: 17, Minor: 5}},
+		},
+		"Invalid": {
+			version: "latest",
+			want:    want{err: errors.Errorf(errParseVersion, "latest")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseServerVersion(tc.version)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.version, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSupports(t *testing.T) {
+	capability := Capability{Name: "feature", Major: 17, Minor: 2}
+
+	cases := map[string]struct {
+		version *ServerVersion
+		want    bool
+	}{
+		"Unknown":     {version: nil, want: true},
+		"OlderMajor":  {version: &ServerVersion{Major: 16, Minor: 11}, want: false},
+		"OlderMinor":  {version: &ServerVersion{Major: 17, Minor: 1}, want: false},
+		"SameVersion": {version: &ServerVersion{Major: 17, Minor: 2}, want: true},
+		"NewerMinor":  {version: &ServerVersion{Major: 17, Minor: 3}, want: true},
+		"NewerMajor":  {version: &ServerVersion{Major: 18, Minor: 0}, want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.version.Supports(capability)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVersionCache(t *testing.T) {
+	now := time.Now()
+	c := NewVersionCache(time.Hour)
+	c.now = func() time.Time { return now }
+
+	m := &mockVersionClient{version: "17.0.0-ee"}
+	want := &ServerVersion{Major: 17, Enterprise: true}
+
+	for i := 0; i < 2; i++ {
+		got, err := c.Get("default", m)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+	}
+	if m.calls != 1 {
+		t.Errorf("expected version to be probed once, got %d", m.calls)
+	}
+
+	if _, err := c.Get("other", m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.calls != 2 {
+		t.Errorf("expected version to be probed per ProviderConfig, got %d", m.calls)
+	}
+
+	now = now.Add(2 * time.Hour)
+	m.err = errors.New("boom")
+	_, err := c.Get("default", m)
+	if diff := cmp.Diff(errors.Wrap(m.err, errGetVersion), err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// TypeConnected indicates whether the provider could reach the GitLab API
+// with the credentials of a ProviderConfig.
+const TypeConnected xpv1.ConditionType = "Connected"
+
+// Reasons a ProviderConfig is or is not connected.
+const (
+	ReasonConnectionSucceeded xpv1.ConditionReason = "ConnectionSucceeded"
+	ReasonConnectionFailed    xpv1.ConditionReason = "ConnectionFailed"
+)
+
+const (
+	healthTimeout = 1 * time.Minute
+
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errUpdateStatus      = "cannot update ProviderConfig status"
+)
+
+// Connected returns a condition that indicates the last connectivity check
+// of a ProviderConfig succeeded.
+func Connected() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConnected,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonConnectionSucceeded,
+	}
+}
+
+// ConnectionFailed returns a condition that indicates the last connectivity
+// check of a ProviderConfig failed with err.
+func ConnectionFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConnected,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonConnectionFailed,
+		Message:            err.Error(),
+	}
+}
+
+// SetupHealth adds a controller that periodically checks whether the GitLab
+// API can be reached with the credentials of each ProviderConfig. The check
+// is repeated as soon as a referenced credentials secret changes, which also
+// rebuilds the cached client of the ProviderConfig with the new token.
+func SetupHealth(mgr ctrl.Manager, o controller.Options) error {
+	name := "health/" + providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
+
+	r := &healthReconciler{
+		kube:               mgr.GetClient(),
+		log:                o.Logger.WithValues("controller", name),
+		metrics:            clients.DefaultAPIMetrics,
+		clients:            clients.DefaultClientCache,
+		interval:           o.PollInterval,
+		newVersionClientFn: clients.NewVersionClient,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(providerConfigsForSecret(mgr.GetClient()))).
+		Complete(r)
+}
+
+// providerConfigsForSecret returns a function that maps a secret to the
+// ProviderConfigs that take their credentials from it.
+func providerConfigsForSecret(kube client.Reader) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		l := &v1beta1.ProviderConfigList{}
+		if err := kube.List(ctx, l); err != nil {
+			return nil
+		}
+		var reqs []reconcile.Request
+		for _, pc := range l.Items {
+			ref := pc.Spec.Credentials.SecretRef
+			if ref == nil || ref.Name != o.GetName() || ref.Namespace != o.GetNamespace() {
+				continue
+			}
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: pc.Name}})
+		}
+		return reqs
+	}
+}
+
+type healthReconciler struct {
+	kube               client.Client
+	log                logging.Logger
+	metrics            *clients.APIMetrics
+	clients            *clients.ClientCache
+	interval           time.Duration
+	newVersionClientFn func(cfg clients.Config) clients.VersionClient
+}
+
+// Reconcile requests the GitLab version with the credentials of a
+// ProviderConfig, records the outcome as its Connected condition and
+// metric, and checks it again after the poll interval.
+func (r *healthReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		if resource.IgnoreNotFound(err) == nil {
+			r.metrics.DeleteConnected(req.Name)
+			r.clients.Invalidate(req.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, errGetProviderConfig)
+	}
+	if meta.WasDeleted(pc) {
+		r.metrics.DeleteConnected(pc.Name)
+		r.clients.Invalidate(pc.Name)
+		return reconcile.Result{}, nil
+	}
+
+	orig := pc.DeepCopy()
+	cfg, err := clients.ConfigFromProviderConfig(ctx, r.kube, pc)
+	if err == nil {
+		_, _, err = r.newVersionClientFn(*cfg).GetVersion(gitlab.WithContext(ctx))
+	}
+	r.metrics.SetConnected(pc.Name, err == nil)
+	if err != nil {
+		log.Debug("GitLab connectivity check failed", "error", err)
+		pc.SetConditions(ConnectionFailed(err))
+	} else {
+		pc.SetConditions(Connected())
+	}
+
+	err = r.kube.Status().Patch(ctx, pc, client.MergeFrom(orig))
+	return reconcile.Result{RequeueAfter: r.interval}, errors.Wrap(resource.IgnoreNotFound(err), errUpdateStatus)
+}
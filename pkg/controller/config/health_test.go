@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+var errBoom = errors.New("boom")
+
+type fakeVersionClient struct {
+	err error
+}
+
+func (c fakeVersionClient) GetVersion(_ ...gitlab.RequestOptionFunc) (*gitlab.Version, *gitlab.Response, error) {
+	return &gitlab.Version{Version: "17.0.0"}, nil, c.err
+}
+
+func providerConfig() *v1beta1.ProviderConfig {
+	pc := &v1beta1.ProviderConfig{}
+	pc.SetName("default")
+	pc.Spec.BaseURL = "https://gitlab.example.com/"
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+	pc.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "gitlab", Namespace: "crossplane-system"},
+		Key:             "token",
+	}
+	return pc
+}
+
+func connectedMetric(v string) string {
+	return `
+# HELP gitlab_provider_config_connected Whether the last connectivity check of a ProviderConfig against the GitLab API succeeded (1) or not (0).
+# TYPE gitlab_provider_config_connected gauge
+gitlab_provider_config_connected{provider_config="default"} ` + v + "\n"
+}
+
+func TestHealthReconcile(t *testing.T) {
+	type want struct {
+		result reconcile.Result
+		err    error
+		reason xpv1.ConditionReason
+		metric string
+	}
+
+	cases := map[string]struct {
+		kube          *test.MockClient
+		versionClient clients.VersionClient
+		want          want
+	}{
+		"ProviderConfigNotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "default")),
+			},
+			want: want{
+				result: reconcile.Result{},
+			},
+		},
+		"GetProviderConfigFailed": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderConfig),
+			},
+		},
+		"Connected": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					switch o := obj.(type) {
+					case *v1beta1.ProviderConfig:
+						*o = *providerConfig()
+					case *corev1.Secret:
+						o.Data = map[string][]byte{"token": []byte("t0k3n")}
+					}
+					return nil
+				},
+			},
+			versionClient: fakeVersionClient{},
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				reason: ReasonConnectionSucceeded,
+				metric: connectedMetric("1"),
+			},
+		},
+		"CredentialsRejected": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					switch o := obj.(type) {
+					case *v1beta1.ProviderConfig:
+						*o = *providerConfig()
+					case *corev1.Secret:
+						o.Data = map[string][]byte{"token": []byte("expired")}
+					}
+					return nil
+				},
+			},
+			versionClient: fakeVersionClient{err: errBoom},
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				reason: ReasonConnectionFailed,
+				metric: connectedMetric("0"),
+			},
+		},
+		"CredentialsSecretMissing": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					if o, ok := obj.(*v1beta1.ProviderConfig); ok {
+						*o = *providerConfig()
+						return nil
+					}
+					return kerrors.NewNotFound(schema.GroupResource{}, "gitlab")
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				reason: ReasonConnectionFailed,
+				metric: connectedMetric("0"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *v1beta1.ProviderConfig
+			tc.kube.MockStatusPatch = func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+				got = obj.(*v1beta1.ProviderConfig)
+				return nil
+			}
+			m := clients.NewAPIMetrics()
+			r := &healthReconciler{
+				kube:     tc.kube,
+				log:      logging.NewNopLogger(),
+				metrics:  m,
+				clients:  clients.NewClientCache(),
+				interval: time.Minute,
+				newVersionClientFn: func(_ clients.Config) clients.VersionClient {
+					return tc.versionClient
+				},
+			}
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKey{Name: "default"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.reason != "" {
+				if got == nil {
+					t.Fatal("r: status was not patched")
+				}
+				if diff := cmp.Diff(tc.want.reason, got.GetCondition(TypeConnected).Reason); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+			if err := testutil.CollectAndCompare(m, strings.NewReader(tc.want.metric), "gitlab_provider_config_connected"); err != nil {
+				t.Errorf("r: %v", err)
+			}
+		})
+	}
+}
+
+func TestProviderConfigsForSecret(t *testing.T) {
+	other := providerConfig()
+	other.SetName("other")
+	other.Spec.Credentials.SecretRef.Name = "other"
+
+	kube := &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*v1beta1.ProviderConfigList).Items = []v1beta1.ProviderConfig{*providerConfig(), *other}
+			return nil
+		},
+	}
+
+	s := &corev1.Secret{}
+	s.SetName("gitlab")
+	s.SetNamespace("crossplane-system")
+
+	want := []reconcile.Request{{NamespacedName: client.ObjectKey{Name: "default"}}}
+	got := providerConfigsForSecret(kube)(context.Background(), s)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("providerConfigsForSecret(...): -want, +got:\n%s", diff)
+	}
+}
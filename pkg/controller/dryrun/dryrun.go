@@ -0,0 +1,158 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun implements the provider-wide dry-run mode, in which
+// controllers observe resources but never change anything in GitLab.
+package dryrun
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+)
+
+// AnnotationKeyPendingChange is set on managed resources while the provider
+// runs in dry-run mode. Its value is the change that would be made in GitLab.
+const AnnotationKeyPendingChange = "gitlab.crossplane.io/dry-run-pending-change"
+
+// Changes that would be made in GitLab outside of dry-run mode.
+const (
+	ChangeCreate = "create"
+	ChangeUpdate = "update"
+	ChangeDelete = "delete"
+)
+
+const (
+	errPatchAnnotation = "cannot update dry-run annotation"
+
+	reasonPendingChange event.Reason = "DryRunPendingChange"
+)
+
+// Connecter returns an ExternalConnecter that wraps c in dry-run mode if the
+// EnableDryRun feature is enabled, and c itself otherwise.
+func Connecter(mgr ctrl.Manager, o controller.Options, name string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	if !o.Features.Enabled(features.EnableDryRun) {
+		return c
+	}
+	return &connecter{
+		kube:      mgr.GetClient(),
+		recorder:  event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		connecter: c,
+	}
+}
+
+type connecter struct {
+	kube      client.Client
+	recorder  event.Recorder
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, recorder: c.recorder, client: ec}, nil
+}
+
+type external struct {
+	kube     client.Client
+	recorder event.Recorder
+	client   managed.ExternalClient
+}
+
+// Observe observes the resource using the wrapped client and reports the
+// change it would require. The resource is then reported as existing and up
+// to date, or as gone if it is being deleted, so that the managed reconciler
+// never calls Create, Update or Delete.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.client.Observe(ctx, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if err := e.report(ctx, mg, PendingChange(mg, o)); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if meta.WasDeleted(mg) {
+		o.ResourceExists = false
+		return o, nil
+	}
+	o.ResourceExists = true
+	o.ResourceUpToDate = true
+	return o, nil
+}
+
+func (e *external) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.client.Disconnect(ctx)
+}
+
+// report records the pending change in the AnnotationKeyPendingChange
+// annotation and emits an event whenever it changes.
+func (e *external) report(ctx context.Context, mg resource.Managed, change string) error {
+	current, ok := mg.GetAnnotations()[AnnotationKeyPendingChange]
+	if current == change && (ok || change == "") {
+		return nil
+	}
+
+	patch := client.MergeFrom(mg.DeepCopyObject().(client.Object))
+	if change == "" {
+		meta.RemoveAnnotations(mg, AnnotationKeyPendingChange)
+	} else {
+		meta.AddAnnotations(mg, map[string]string{AnnotationKeyPendingChange: change})
+		e.recorder.Event(mg, event.Normal(reasonPendingChange, "Dry run: would "+change+" external resource"))
+	}
+	return errors.Wrap(e.kube.Patch(ctx, mg, patch), errPatchAnnotation)
+}
+
+// PendingChange returns the change that the managed reconciler would make in
+// GitLab given the supplied observation, or an empty string if there is none.
+func PendingChange(mg resource.Managed, o managed.ExternalObservation) string {
+	switch {
+	case meta.WasDeleted(mg):
+		if o.ResourceExists {
+			return ChangeDelete
+		}
+		return ""
+	case !o.ResourceExists:
+		return ChangeCreate
+	case !o.ResourceUpToDate:
+		return ChangeUpdate
+	}
+	return ""
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var errBoom = errors.New("boom")
+
+type managedModifier func(*fake.Managed)
+
+func withPendingChange(c string) managedModifier {
+	return func(m *fake.Managed) { m.SetAnnotations(map[string]string{AnnotationKeyPendingChange: c}) }
+}
+
+func withDeletionTimestamp() managedModifier {
+	return func(m *fake.Managed) { m.SetDeletionTimestamp(&metav1.Time{Time: time.Now()}) }
+}
+
+func mr(m ...managedModifier) *fake.Managed {
+	cr := &fake.Managed{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		observation managed.ExternalObservation
+		observeErr  error
+		patchErr    error
+		cr          *fake.Managed
+	}
+	type want struct {
+		result  managed.ExternalObservation
+		err     error
+		change  string
+		patched bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ObserveFailed": {
+			args: args{
+				observeErr: errBoom,
+				cr:         mr(),
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"WouldCreate": {
+			args: args{
+				observation: managed.ExternalObservation{ResourceExists: false},
+				cr:          mr(),
+			},
+			want: want{
+				result:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				change:  ChangeCreate,
+				patched: true,
+			},
+		},
+		"WouldUpdate": {
+			args: args{
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cr:          mr(),
+			},
+			want: want{
+				result:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				change:  ChangeUpdate,
+				patched: true,
+			},
+		},
+		"WouldDelete": {
+			args: args{
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cr:          mr(withDeletionTimestamp()),
+			},
+			want: want{
+				result:  managed.ExternalObservation{ResourceExists: false, ResourceUpToDate: true},
+				change:  ChangeDelete,
+				patched: true,
+			},
+		},
+		"UnchangedPendingChange": {
+			args: args{
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cr:          mr(withPendingChange(ChangeUpdate)),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				change: ChangeUpdate,
+			},
+		},
+		"NoLongerPending": {
+			args: args{
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cr:          mr(withPendingChange(ChangeUpdate)),
+			},
+			want: want{
+				result:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				patched: true,
+			},
+		},
+		"UpToDate": {
+			args: args{
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cr:          mr(),
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PatchFailed": {
+			args: args{
+				observation: managed.ExternalObservation{ResourceExists: false},
+				patchErr:    errBoom,
+				cr:          mr(),
+			},
+			want: want{
+				err:     errors.Wrap(errBoom, errPatchAnnotation),
+				change:  ChangeCreate,
+				patched: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := false
+			e := &external{
+				kube: &test.MockClient{
+					MockPatch: func(_ context.Context, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						patched = true
+						return tc.args.patchErr
+					},
+				},
+				recorder: event.NewNopRecorder(),
+				client: &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return tc.args.observation, tc.args.observeErr
+					},
+				},
+			}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.change, tc.args.cr.GetAnnotations()[AnnotationKeyPendingChange]); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package forbidden stops controllers from backing off and retrying
+// observations that GitLab rejects because the provider's credentials lack
+// the required permissions.
+package forbidden
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+)
+
+// ReasonInsufficientPermissions is set on the Ready condition of a managed
+// resource when GitLab refuses to let the provider observe it.
+const ReasonInsufficientPermissions xpv1.ConditionReason = "InsufficientPermissions"
+
+const reasonInsufficientPermissions event.Reason = "InsufficientPermissions"
+
+// InsufficientPermissions returns a condition that indicates the provider's
+// credentials are not allowed to observe the external resource.
+func InsufficientPermissions(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInsufficientPermissions,
+		Message:            err.Error(),
+	}
+}
+
+// Connecter returns an ExternalConnecter that wraps the clients connected by
+// c. When an observation fails with 401 Unauthorized or 403 Forbidden the
+// resource is marked with the InsufficientPermissions condition and reported
+// as up to date, so that it is observed again after the regular poll interval
+// instead of being requeued with backoff. Resources being deleted are not
+// affected.
+func Connecter(mgr ctrl.Manager, name string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{
+		recorder:  event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		connecter: c,
+	}
+}
+
+type connecter struct {
+	recorder  event.Recorder
+	connecter managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{recorder: c.recorder, client: ec}, nil
+}
+
+type external struct {
+	recorder event.Recorder
+	client   managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.client.Observe(ctx, mg)
+	if err == nil || meta.WasDeleted(mg) || !clients.IsErrorForbidden(err) {
+		return o, err
+	}
+
+	mg.SetConditions(InsufficientPermissions(err))
+	e.recorder.Event(mg, event.Warning(reasonInsufficientPermissions, err))
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return e.client.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return e.client.Update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	return e.client.Delete(ctx, mg)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	return e.client.Disconnect(ctx)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forbidden
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var errBoom = errors.New("boom")
+
+// countingRecorder counts the events it is asked to record.
+type countingRecorder struct {
+	events int
+}
+
+func (r *countingRecorder) Event(_ runtime.Object, _ event.Event) { r.events++ }
+
+func (r *countingRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func errStatus(code int) error {
+	req, _ := http.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects/1", nil)
+	res := &http.Response{StatusCode: code, Request: req}
+	return errors.Wrap(&gitlab.ErrorResponse{Response: res, Message: http.StatusText(code)}, "cannot get Gitlab resource")
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		observation managed.ExternalObservation
+		observeErr  error
+		deleted     bool
+	}
+	type want struct {
+		result    managed.ExternalObservation
+		err       error
+		condition *xpv1.Condition
+		events    int
+	}
+
+	forbiddenErr := errStatus(http.StatusForbidden)
+	unauthorizedErr := errStatus(http.StatusUnauthorized)
+	forbiddenCondition := InsufficientPermissions(forbiddenErr)
+	unauthorizedCondition := InsufficientPermissions(unauthorizedErr)
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Success": {
+			args: args{
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"TransientError": {
+			args: args{
+				observeErr: errBoom,
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"ServerError": {
+			args: args{
+				observeErr: errStatus(http.StatusInternalServerError),
+			},
+			want: want{
+				err: errStatus(http.StatusInternalServerError),
+			},
+		},
+		"Forbidden": {
+			args: args{
+				observeErr: forbiddenErr,
+			},
+			want: want{
+				result:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: &forbiddenCondition,
+				events:    1,
+			},
+		},
+		"Unauthorized": {
+			args: args{
+				observeErr: unauthorizedErr,
+			},
+			want: want{
+				result:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: &unauthorizedCondition,
+				events:    1,
+			},
+		},
+		"ForbiddenWhileDeleting": {
+			args: args{
+				observeErr: forbiddenErr,
+				deleted:    true,
+			},
+			want: want{
+				err: forbiddenErr,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &fake.Managed{}
+			if tc.args.deleted {
+				cr.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+			}
+			rec := &countingRecorder{}
+			e := &external{
+				recorder: rec,
+				client: &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return tc.args.observation, tc.args.observeErr
+					},
+				},
+			}
+
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if tc.want.condition != nil {
+				if diff := cmp.Diff(*tc.want.condition, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("Observe(...): -want condition, +got condition:\n%s", diff)
+				}
+			}
+			if rec.events != tc.want.events {
+				t.Errorf("Observe(...): want %d events, got %d", tc.want.events, rec.events)
+			}
+		})
+	}
+}
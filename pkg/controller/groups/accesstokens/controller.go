@@ -18,7 +18,7 @@ package accesstokens
 
 import (
 	"context"
-	"strconv"
+	"fmt"
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -39,7 +39,11 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -49,9 +53,14 @@ const (
 	errFailedParseID        = "cannot parse Access Token ID to int"
 	errGetFailed            = "cannot get Gitlab accesstoken"
 	errCreateFailed         = "cannot create Gitlab accesstoken"
+	errRotateFailed         = "cannot rotate Gitlab accesstoken"
+	errKubeUpdateFailed     = "cannot update Gitlab accesstoken custom resource"
 	errDeleteFailed         = "cannot delete Gitlab accesstoken"
 	errAccessTokentNotFound = "cannot find Gitlab accesstoken"
 	errMissingGroupID       = "missing Spec.ForProvider.GroupID"
+	errExpiresAtInPast      = "Spec.ForProvider.ExpiresAt must be in the future"
+
+	reasonRotated event.Reason = "RotatedAccessToken"
 )
 
 // SetupAccessToken adds a controller that reconciles GroupAccessTokens.
@@ -63,12 +72,15 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewAccessTokenClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: groups.NewAccessTokenClient, newGroupGetterFn: groups.NewGroupGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -93,7 +105,9 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 
 type connector struct {
 	kube              client.Client
+	recorder          event.Recorder
 	newGitlabClientFn func(cfg clients.Config) groups.AccessTokenClient
+	newGroupGetterFn  func(cfg clients.Config) groups.GroupGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -105,12 +119,20 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	if cr.Spec.ForProvider.GroupID == nil && cr.Spec.ForProvider.GroupPath != nil {
+		id, err := groups.ResolveGroupPath(ctx, c.newGroupGetterFn(*cfg), *cr.Spec.ForProvider.GroupPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.GroupID = &id
+	}
+	return &external{kube: c.kube, recorder: c.recorder, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client groups.AccessTokenClient
+	kube     client.Client
+	recorder event.Recorder
+	client   groups.AccessTokenClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -124,7 +146,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, nil
 	}
 
-	accessTokenID, err := strconv.Atoi(externalName)
+	accessTokenID, err := externalname.ParseID(externalName)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errFailedParseID)
 	}
@@ -144,11 +166,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitializeGroupAccessToken(&cr.Spec.ForProvider, at)
 
-	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = groups.GenerateGroupAccessTokenObservation(at)
+	cr.Status.SetConditions(xpv1.Available(), clients.TokenExpiring(at.ExpiresAt, cr.Spec.ForProvider.ExpiryWarningDays, time.Now()))
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        true,
+		ResourceUpToDate:        !groups.IsGroupAccessTokenExpiring(&cr.Spec.ForProvider, at, time.Now()),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -163,6 +186,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errMissingGroupID)
 	}
 
+	// CEL has no notion of the current time, so this can't be validated by
+	// the API server.
+	if at := cr.Spec.ForProvider.ExpiresAt; at != nil && !at.After(time.Now()) {
+		return managed.ExternalCreation{}, errors.New(errExpiresAtInPast)
+	}
+
 	at, _, err := e.client.CreateGroupAccessToken(
 		*cr.Spec.ForProvider.GroupID,
 		groups.GenerateCreateGroupAccessTokenOptions(cr.Name, &cr.Spec.ForProvider),
@@ -173,7 +202,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
-	meta.SetExternalName(cr, strconv.Itoa(at.ID))
+	meta.SetExternalName(cr, externalname.FormatID(at.ID))
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{
 			"token": []byte(at.Token),
@@ -182,8 +211,50 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// it's not possible to update a GroupAccessToken
-	return managed.ExternalUpdate{}, nil
+	cr, ok := mg.(*v1alpha1.AccessToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccessToken)
+	}
+
+	// it's not possible to update a GroupAccessToken, it can only be rotated
+	// according to its expiresAt policy
+	if cr.Spec.ForProvider.ExpiresAtPolicy == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	oldID, err := externalname.ParseID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errExternalNameNotInt)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errMissingGroupID)
+	}
+
+	// GitLab revokes the old token and returns a new one with a new ID.
+	at, _, err := e.client.RotateGroupAccessToken(
+		*cr.Spec.ForProvider.GroupID,
+		oldID,
+		groups.GenerateRotateGroupAccessTokenOptions(&cr.Spec.ForProvider, time.Now()),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotateFailed)
+	}
+
+	meta.SetExternalName(cr, externalname.FormatID(at.ID))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+
+	e.recorder.Event(cr, event.Normal(reasonRotated, fmt.Sprintf(
+		"Access token %d was rotated to %d because it was about to expire", oldID, at.ID)))
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			"token": []byte(at.Token),
+		},
+	}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -192,7 +263,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotAccessToken)
 	}
 
-	accessTokenID, err := strconv.Atoi(meta.GetExternalName(cr))
+	accessTokenID, err := externalname.ParseID(meta.GetExternalName(cr))
 
 	if err != nil {
 		return managed.ExternalDelete{}, errors.New(errExternalNameNotInt)
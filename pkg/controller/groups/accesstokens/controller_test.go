@@ -25,6 +25,7 @@ import (
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -32,10 +33,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
 )
@@ -51,6 +54,11 @@ var (
 	accessLevel    = 40
 	name           = "Access Token Name"
 	token          = "Token"
+	expiresSoon    = time.Now().AddDate(0, 0, 3)
+	rotatePolicy   = v1alpha1.ExpiresAtPolicy{
+		RotateDaysBefore: 7,
+		ValidityDays:     90,
+	}
 	accessTokenObj = gitlab.GroupAccessToken{
 		ID:          accessTokenID,
 		Name:        name,
@@ -79,6 +87,10 @@ func withSpec(fp v1alpha1.AccessTokenParameters) accessTokenModifier {
 	return func(r *v1alpha1.AccessToken) { r.Spec.ForProvider = fp }
 }
 
+func withStatus(o v1alpha1.AccessTokenObservation) accessTokenModifier {
+	return func(r *v1alpha1.AccessToken) { r.Status.AtProvider = o }
+}
+
 func withExternalName(accessTokenID string) accessTokenModifier {
 	return func(r *v1alpha1.AccessToken) { meta.SetExternalName(r, accessTokenID) }
 }
@@ -102,6 +114,18 @@ func TestObserve(t *testing.T) {
 		err    error
 	}
 
+	notExpiring := xpv1.Condition{
+		Type:   clients.TypeTokenExpiring,
+		Status: corev1.ConditionFalse,
+		Reason: clients.ReasonTokenNotExpiring,
+	}
+	expiring := xpv1.Condition{
+		Type:    clients.TypeTokenExpiring,
+		Status:  corev1.ConditionTrue,
+		Reason:  clients.ReasonTokenExpiring,
+		Message: "Access token expires at " + expiresSoon.Format(time.RFC3339),
+	}
+
 	cases := map[string]struct {
 		args
 		want
@@ -237,7 +261,8 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
-					withConditions(xpv1.Available()),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: gitlab.Ptr(0)}),
 					withSpec(v1alpha1.AccessTokenParameters{
 						GroupID:     &id,
 						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
@@ -271,7 +296,8 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
-					withConditions(xpv1.Available()),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: gitlab.Ptr(0), ExpiresAt: &v1.Time{Time: expiresAt}}),
 					withSpec(v1alpha1.AccessTokenParameters{
 						GroupID:     &id,
 						ExpiresAt:   &v1.Time{Time: expiresAt},
@@ -304,7 +330,8 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
-					withConditions(xpv1.Available()),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: gitlab.Ptr(0)}),
 					withSpec(v1alpha1.AccessTokenParameters{
 						GroupID:     &id,
 						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
@@ -318,6 +345,42 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AccessTokenExpiring": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetGroupAccessToken: func(gid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error) {
+						return &gitlab.GroupAccessToken{ExpiresAt: (*gitlab.ISOTime)(&expiresSoon)}, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID:         &id,
+						AccessLevel:     (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:       &v1.Time{Time: expiresSoon},
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withConditions(xpv1.Available(), expiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: gitlab.Ptr(0), ExpiresAt: &v1.Time{Time: expiresSoon}}),
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID:         &id,
+						AccessLevel:     (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:       &v1.Time{Time: expiresSoon},
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -369,6 +432,26 @@ func TestCreate(t *testing.T) {
 				err:    errors.New(errMissingGroupID),
 			},
 		},
+		"ExpiresAtInPast": {
+			args: args{
+				cr: accessToken(
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID:   &id,
+						ExpiresAt: &v1.Time{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID:   &id,
+						ExpiresAt: &v1.Time{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+					}),
+				),
+				result: managed.ExternalCreation{},
+				err:    errors.New(errExpiresAtInPast),
+			},
+		},
 		"CreationFailedErr": {
 			args: args{
 				accessTokenClient: &fake.MockClient{
@@ -486,10 +569,67 @@ func TestUpdate(t *testing.T) {
 				result: managed.ExternalUpdate{},
 			},
 		},
+		"RotateFailed": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockRotateGroupAccessToken: func(gid interface{}, id int, opt *gitlab.RotateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID:         &id,
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID:         &id,
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+				err: errors.Wrap(errBoom, errRotateFailed),
+			},
+		},
+		"SuccessfulRotate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				accessTokenClient: &fake.MockClient{
+					MockRotateGroupAccessToken: func(gid interface{}, id int, opt *gitlab.RotateGroupAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.GroupAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID:         &id,
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						GroupID:         &id,
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.accessTokenClient}
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.accessTokenClient}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
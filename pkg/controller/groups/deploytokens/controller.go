@@ -18,7 +18,6 @@ package deploytokens
 
 import (
 	"context"
-	"strconv"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -38,7 +37,11 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -61,9 +64,10 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewDeployTokenClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewDeployTokenClient, newGroupGetterFn: groups.NewGroupGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -91,6 +95,7 @@ func SetupDeployToken(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg clients.Config) groups.DeployTokenClient
+	newGroupGetterFn  func(cfg clients.Config) groups.GroupGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -102,6 +107,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
+	if cr.Spec.ForProvider.GroupID == nil && cr.Spec.ForProvider.GroupPath != nil {
+		id, err := groups.ResolveGroupPath(ctx, c.newGroupGetterFn(*cfg), *cr.Spec.ForProvider.GroupPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.GroupID = &id
+	}
 	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
@@ -121,7 +133,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	id, err := strconv.Atoi(externalName)
+	id, err := externalname.ParseID(externalName)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errIDNotInt)
 	}
@@ -167,14 +179,15 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		gitlab.WithContext(ctx),
 	)
 
-	connectionDetails := managed.ConnectionDetails{}
-	connectionDetails["token"] = []byte(dt.Token)
-
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
-	meta.SetExternalName(cr, strconv.Itoa(dt.ID))
+	connectionDetails := managed.ConnectionDetails{}
+	connectionDetails["username"] = []byte(dt.Username)
+	connectionDetails["token"] = []byte(dt.Token)
+
+	meta.SetExternalName(cr, externalname.FormatID(dt.ID))
 	return managed.ExternalCreation{ConnectionDetails: connectionDetails}, nil
 }
 
@@ -189,7 +202,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotDeployToken)
 	}
 
-	deployTokenID, err := strconv.Atoi(meta.GetExternalName(cr))
+	deployTokenID, err := externalname.ParseID(meta.GetExternalName(cr))
 
 	if err != nil {
 		return managed.ExternalDelete{}, errors.New(errNotDeployToken)
@@ -332,7 +332,7 @@ func TestCreate(t *testing.T) {
 					}),
 				),
 				result: managed.ExternalCreation{
-					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+					ConnectionDetails: managed.ConnectionDetails{"username": []byte(username), "token": []byte(token)},
 				},
 			},
 		},
@@ -340,7 +340,7 @@ func TestCreate(t *testing.T) {
 			args: args{
 				deployToken: &fake.MockClient{
 					MockCreateGroupDeployToken: func(pid interface{}, opt *gitlab.CreateGroupDeployTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.DeployToken, *gitlab.Response, error) {
-						return &gitlab.DeployToken{}, &gitlab.Response{}, errBoom
+						return nil, &gitlab.Response{}, errBoom
 					},
 				},
 				cr: deployToken(
@@ -0,0 +1,284 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package epics
+
+import (
+	"context"
+	"sort"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+)
+
+const (
+	errNotEpic        = "managed resource is not a Gitlab epic custom resource"
+	errGetFailed      = "cannot get Gitlab epic"
+	errCreateFailed   = "cannot create Gitlab epic"
+	errUpdateFailed   = "cannot update Gitlab epic"
+	errDeleteFailed   = "cannot delete Gitlab epic"
+	errIDNotInt       = "external-name is not an int"
+	errGroupIDMissing = "GroupID is missing"
+)
+
+// SetupEpic adds a controller that reconciles Epics.
+func SetupEpic(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.EpicKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewEpicClient}))),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.EpicGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.EpicList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Epic{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) groups.EpicClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Epic)
+	if !ok {
+		return nil, errors.New(errNotEpic)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client groups.EpicClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Epic)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEpic)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	iid, err := externalname.ParseID(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
+	}
+
+	epic, res, err := e.client.GetEpic(*cr.Spec.ForProvider.GroupID, iid)
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitializeEpic(&cr.Spec.ForProvider, epic)
+
+	cr.Status.AtProvider = groups.GenerateEpicObservation(epic)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isEpicUpToDate(&cr.Spec.ForProvider, epic),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Epic)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEpic)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errGroupIDMissing)
+	}
+
+	epic, _, err := e.client.CreateEpic(
+		*cr.Spec.ForProvider.GroupID,
+		groups.GenerateCreateEpicOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, externalname.FormatID(epic.IID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Epic)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEpic)
+	}
+
+	iid, err := externalname.ParseID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errGroupIDMissing)
+	}
+
+	_, _, err = e.client.UpdateEpic(
+		*cr.Spec.ForProvider.GroupID,
+		iid,
+		groups.GenerateUpdateEpicOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Epic)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotEpic)
+	}
+
+	iid, err := externalname.ParseID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalDelete{}, errors.New(errGroupIDMissing)
+	}
+
+	_, err = e.client.DeleteEpic(
+		*cr.Spec.ForProvider.GroupID,
+		iid,
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// lateInitializeEpic fills the empty fields in the epic spec with the
+// values seen in gitlab epic.
+func lateInitializeEpic(in *v1alpha1.EpicParameters, epic *gitlab.Epic) {
+	if epic == nil {
+		return
+	}
+
+	in.Description = clients.LateInitializeStringPtr(in.Description, epic.Description)
+
+	if in.Confidential == nil {
+		in.Confidential = &epic.Confidential
+	}
+}
+
+// isEpicUpToDate checks whether there is a change in any of the modifiable fields.
+func isEpicUpToDate(p *v1alpha1.EpicParameters, e *gitlab.Epic) bool {
+	if p.Title != e.Title {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Description, e.Description) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.Confidential, e.Confidential) {
+		return false
+	}
+	if !clients.IsIntEqualToIntPtr(p.ParentID, e.ParentID) {
+		return false
+	}
+	if p.Labels != nil && !isLabelsEqual(p.Labels, e.Labels) {
+		return false
+	}
+	if p.StartDate != nil && !groups.IsEpicDateEqual(&p.StartDate.Time, e.StartDateIsFixed, e.StartDateFixed) {
+		return false
+	}
+	if p.DueDate != nil && !groups.IsEpicDateEqual(&p.DueDate.Time, e.DueDateIsFixed, e.DueDateFixed) {
+		return false
+	}
+	return true
+}
+
+// isLabelsEqual compares labels regardless of their order.
+func isLabelsEqual(want, got []string) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	w := append([]string{}, want...)
+	g := append([]string{}, got...)
+	sort.Strings(w)
+	sort.Strings(g)
+	return cmp.Equal(w, g)
+}
@@ -0,0 +1,552 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package epics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	groupID       = 1234
+	epicID        = 42
+	epicIID       = 7
+	sEpicIID      = strconv.Itoa(epicIID)
+	title         = "Roadmap"
+	description   = "Portfolio roadmap"
+	startDate     = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	isoStartDate  = gitlab.ISOTime(startDate)
+	epicObj       = &gitlab.Epic{
+		ID:               epicID,
+		IID:              epicIID,
+		GroupID:          groupID,
+		Title:            title,
+		Description:      description,
+		State:            "opened",
+		Labels:           []string{"b", "a"},
+		StartDateIsFixed: true,
+		StartDateFixed:   &isoStartDate,
+	}
+)
+
+type args struct {
+	epic groups.EpicClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type epicModifier func(*v1alpha1.Epic)
+
+func withConditions(c ...xpv1.Condition) epicModifier {
+	return func(r *v1alpha1.Epic) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.EpicParameters) epicModifier {
+	return func(r *v1alpha1.Epic) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.EpicObservation) epicModifier {
+	return func(r *v1alpha1.Epic) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) epicModifier {
+	return func(r *v1alpha1.Epic) { meta.SetExternalName(r, n) }
+}
+
+func epic(m ...epicModifier) *v1alpha1.Epic {
+	cr := &v1alpha1.Epic{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotEpic),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: epic(),
+			},
+			want: want{
+				cr: epic(),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: epic(withExternalName("fr")),
+			},
+			want: want{
+				cr:  epic(withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"GroupIDMissing": {
+			args: args{
+				cr: epic(withExternalName(sEpicIID)),
+			},
+			want: want{
+				cr:  epic(withExternalName(sEpicIID)),
+				err: errors.New(errGroupIDMissing),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				epic: &fake.MockClient{
+					MockGetEpic: func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID}),
+				),
+			},
+			want: want{
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID}),
+				),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ErrGet404": {
+			args: args{
+				epic: &fake.MockClient{
+					MockGetEpic: func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID}),
+				),
+			},
+			want: want{
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID}),
+				),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				epic: &fake.MockClient{
+					MockGetEpic: func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+						return epicObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title}),
+				),
+			},
+			want: want{
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{
+						GroupID:      &groupID,
+						Title:        title,
+						Description:  &description,
+						Confidential: gitlab.Ptr(false),
+					}),
+					withStatus(v1alpha1.EpicObservation{ID: epicID, IID: epicIID, State: "opened"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				epic: &fake.MockClient{
+					MockGetEpic: func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+						return epicObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{
+						GroupID:      &groupID,
+						Title:        "Another title",
+						Description:  &description,
+						Confidential: gitlab.Ptr(false),
+					}),
+				),
+			},
+			want: want{
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{
+						GroupID:      &groupID,
+						Title:        "Another title",
+						Description:  &description,
+						Confidential: gitlab.Ptr(false),
+					}),
+					withStatus(v1alpha1.EpicObservation{ID: epicID, IID: epicIID, State: "opened"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.epic}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotEpic),
+			},
+		},
+		"GroupIDMissing": {
+			args: args{
+				cr: epic(),
+			},
+			want: want{
+				cr:  epic(),
+				err: errors.New(errGroupIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				epic: &fake.MockClient{
+					MockCreateEpic: func(gid interface{}, opt *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+						return epicObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: epic(withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title})),
+			},
+			want: want{
+				cr: epic(
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title}),
+					withExternalName(sEpicIID),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				epic: &fake.MockClient{
+					MockCreateEpic: func(gid interface{}, opt *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: epic(withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title})),
+			},
+			want: want{
+				cr:  epic(withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title})),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.epic}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotEpic),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				epic: &fake.MockClient{
+					MockUpdateEpic: func(gid interface{}, epic int, opt *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+						return epicObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title}),
+				),
+			},
+			want: want{
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title}),
+				),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				epic: &fake.MockClient{
+					MockUpdateEpic: func(gid interface{}, epic int, opt *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title}),
+				),
+			},
+			want: want{
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID, Title: title}),
+				),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.epic}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotEpic),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				epic: &fake.MockClient{
+					MockDeleteEpic: func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID}),
+				),
+			},
+			want: want{
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID}),
+				),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				epic: &fake.MockClient{
+					MockDeleteEpic: func(gid interface{}, epic int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID}),
+				),
+			},
+			want: want{
+				cr: epic(
+					withExternalName(sEpicIID),
+					withSpec(v1alpha1.EpicParameters{GroupID: &groupID}),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.epic}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEpicUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.EpicParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: &v1alpha1.EpicParameters{
+				Title:     title,
+				Labels:    []string{"a", "b"},
+				StartDate: &metav1.Time{Time: startDate},
+			},
+			want: true,
+		},
+		"LabelsChanged": {
+			p: &v1alpha1.EpicParameters{
+				Title:  title,
+				Labels: []string{"a"},
+			},
+			want: false,
+		},
+		"StartDateChanged": {
+			p: &v1alpha1.EpicParameters{
+				Title:     title,
+				StartDate: &metav1.Time{Time: startDate.AddDate(0, 0, 1)},
+			},
+			want: false,
+		},
+		"DueDateNotFixed": {
+			p: &v1alpha1.EpicParameters{
+				Title:   title,
+				DueDate: &metav1.Time{Time: startDate},
+			},
+			want: false,
+		},
+		"ParentChanged": {
+			p: &v1alpha1.EpicParameters{
+				Title:    title,
+				ParentID: gitlab.Ptr(1),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, isEpicUpToDate(tc.p, epicObj)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
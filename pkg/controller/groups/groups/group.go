@@ -18,7 +18,7 @@ package groups
 
 import (
 	"context"
-	"strconv"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +26,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -34,13 +35,18 @@ import (
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -56,6 +62,11 @@ const (
 	errMissingGroupID    = "missing group ID for group to share with"
 	errSWGMissingGroupID = "FOllowing SharedWithGroup is missing GroupID: %v"
 	errLateInitialize    = "Error during LateInitialization: "
+	errLDAPSyncFailed    = "cannot sync Gitlab Group with LDAP"
+
+	reasonUnsupported       event.Reason = "UnsupportedFeature"
+	reasonLDAPSyncTriggered event.Reason = "LDAPSyncTriggered"
+	reasonNotUpToDate       event.Reason = "NotUpToDate"
 )
 
 // SetupGroup adds a controller that reconciles Groups.
@@ -67,12 +78,23 @@ func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewGroupClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{
+			kube:               mgr.GetClient(),
+			logger:             logger,
+			recorder:           recorder,
+			versions:           clients.NewVersionCache(clients.DefaultVersionCacheTTL),
+			newGitlabClientFn:  groups.NewGroupClient,
+			newVersionClientFn: clients.NewVersionClient,
+		}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollIntervalHook(pollinterval.Hook),
+		managed.WithLogger(logger),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -96,8 +118,12 @@ func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg clients.Config) groups.Client
+	kube               client.Client
+	logger             logging.Logger
+	recorder           event.Recorder
+	versions           *clients.VersionCache
+	newGitlabClientFn  func(cfg clients.Config) groups.Client
+	newVersionClientFn func(cfg clients.Config) clients.VersionClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -109,12 +135,20 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+
+	// An unknown version is treated as supporting every capability, so a
+	// failing version probe does not block reconciliation.
+	version, _ := c.versions.Get(cr.GetProviderConfigReference().Name, c.newVersionClientFn(*cfg))
+
+	return &external{kube: c.kube, logger: c.logger, recorder: c.recorder, version: version, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client groups.Client
+	kube     client.Client
+	logger   logging.Logger
+	recorder event.Recorder
+	version  *clients.ServerVersion
+	client   groups.Client
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -128,10 +162,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	groupID, err := strconv.Atoi(externalName)
+	ref, err := externalname.ParsePathOrID(externalName)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errIDNotInt)
 	}
+	groupID := ref.PID()
 
 	//nolint:staticcheck // Keeping this for backward compatibility during deprecation
 	cr.Spec.ForProvider.EmailsEnabled = lateInitializeEmailsEnabled(cr.Spec.ForProvider.EmailsEnabled, cr.Spec.ForProvider.EmailsDisabled)
@@ -144,24 +179,49 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	// A group pending delayed deletion is considered gone if requested, so
+	// that the finalizer does not block until GitLab removes it for good.
+	if meta.WasDeleted(cr) && grp.MarkedForDeletionOn != nil && ptr.Deref(cr.Spec.ForProvider.RemoveFinalizerOnPendingDeletion, false) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A group imported by its full path is tracked by its ID from now on,
+	// which survives renames and transfers.
+	if ref.IsPath() {
+		groupID = grp.ID
+		meta.SetExternalName(cr, externalname.FormatID(grp.ID))
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 
 	err = lateInitialize(&cr.Spec.ForProvider, grp)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
-	isResourceLateInitialized := !cmp.Equal(current, &cr.Spec.ForProvider)
+	isResourceLateInitialized := ref.IsPath() || !cmp.Equal(current, &cr.Spec.ForProvider)
 
+	ldapSync := cr.Status.AtProvider.LDAPSync
 	cr.Status.AtProvider = groups.GenerateObservation(grp)
+	cr.Status.AtProvider.LDAPSync = ldapSync
 	cr.Status.SetConditions(xpv1.Available())
-	isUpToDate, err := isGroupUpToDate(&cr.Spec.ForProvider, grp)
+	params, _ := supportedParameters(&cr.Spec.ForProvider, e.version)
+	diff, err := groupDiff(params, grp)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
+	if groups.NeedsGroupSettings(params) {
+		gs, _, err := e.client.GetGroupSettings(groupID, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+		}
+		diff = append(diff, groups.GroupSettingsDiff(params, gs)...)
+	}
+	e.reportDiff(cr, diff)
+	_, ldapSyncPending := ldapSyncTrigger(cr)
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isUpToDate,
+		ResourceUpToDate:        len(diff) == 0 && !ldapSyncPending,
 		ResourceLateInitialized: isResourceLateInitialized,
 		ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte(grp.RunnersToken)},
 	}, nil
@@ -174,14 +234,14 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	grp, _, err := e.client.CreateGroup(
-		groups.GenerateCreateGroupOptions(cr.Name, &cr.Spec.ForProvider),
+		groups.GenerateCreateGroupOptions(cr.Name, e.supportedParameters(cr)),
 		gitlab.WithContext(ctx),
 	)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
-	meta.SetExternalName(cr, strconv.Itoa(grp.ID))
+	meta.SetExternalName(cr, externalname.FormatID(grp.ID))
 	return managed.ExternalCreation{}, nil
 }
 
@@ -191,15 +251,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotGroup)
 	}
+	params := e.supportedParameters(cr)
 	grp, _, err := e.client.UpdateGroup(
 		meta.GetExternalName(cr),
-		groups.GenerateEditGroupOptions(cr.Name, &cr.Spec.ForProvider),
+		groups.GenerateEditGroupOptions(cr.Name, params),
 		gitlab.WithContext(ctx),
 	)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 	}
 
+	if opt := groups.GenerateGroupSettingsOptions(params); opt != nil {
+		if _, _, err := e.client.UpdateGroupSettings(grp.ID, opt, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+		}
+	}
+
 	if len(cr.Spec.ForProvider.SharedWithGroups) > 0 {
 		for _, sh := range cr.Spec.ForProvider.SharedWithGroups {
 			if sh.GroupID == nil {
@@ -236,6 +303,17 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
+	if trigger, pending := ldapSyncTrigger(cr); pending {
+		if _, err := e.client.SyncGroupLDAP(grp.ID, gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errLDAPSyncFailed)
+		}
+		cr.Status.AtProvider.LDAPSync = &v1alpha1.LDAPSyncObservation{
+			Trigger:      trigger,
+			LastSyncTime: &metav1.Time{Time: time.Now()},
+		}
+		e.recorder.Event(cr, event.Normal(reasonLDAPSyncTriggered, "Successfully requested LDAP sync of the group"))
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -267,66 +345,125 @@ func (e *external) Disconnect(ctx context.Context) error {
 	return nil
 }
 
-// isGroupUpToDate checks whether there is a change in any of the modifiable fields.
-func isGroupUpToDate(p *v1alpha1.GroupParameters, g *gitlab.Group) (bool, error) { //nolint:gocyclo
-	if p.Name != nil && !cmp.Equal(*p.Name, g.Name) {
-		return false, nil
-	}
-	if !cmp.Equal(p.Path, g.Path) {
-		return false, nil
-	}
-	if !cmp.Equal(p.Description, clients.StringToPtr(g.Description)) {
-		return false, nil
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.MembershipLock, g.MembershipLock) {
-		return false, nil
+// ldapSyncTrigger returns the value of the LDAP sync trigger annotation and
+// whether it requests a sync that has not been performed yet.
+func ldapSyncTrigger(cr *v1alpha1.Group) (string, bool) {
+	trigger := cr.GetAnnotations()[v1alpha1.AnnotationKeyTriggerLDAPSync]
+	if trigger == "" {
+		return "", false
 	}
-	if (p.Visibility != nil) && (!cmp.Equal(string(*p.Visibility), string(g.Visibility))) {
-		return false, nil
-	}
-	if (p.ProjectCreationLevel != nil) && (!cmp.Equal(string(*p.ProjectCreationLevel), string(g.ProjectCreationLevel))) {
-		return false, nil
+	last := cr.Status.AtProvider.LDAPSync
+	return trigger, last == nil || last.Trigger != trigger
+}
+
+// supportedParameters returns the group parameters adjusted to the GitLab
+// version and emits an event for every setting that cannot be applied,
+// rather than letting the API reject the request.
+func (e *external) supportedParameters(cr *v1alpha1.Group) *v1alpha1.GroupParameters {
+	p, unsupported := supportedParameters(&cr.Spec.ForProvider, e.version)
+	for _, c := range unsupported {
+		e.recorder.Event(cr, event.Warning(reasonUnsupported, e.version.UnsupportedError(c)))
 	}
-	if (p.SubGroupCreationLevel != nil) && (!cmp.Equal(string(*p.SubGroupCreationLevel), string(g.SubGroupCreationLevel))) {
-		return false, nil
+	return p
+}
+
+// supportedParameters returns a copy of the group parameters without the
+// settings the given GitLab version does not support, along with the
+// capabilities that were dropped.
+func supportedParameters(in *v1alpha1.GroupParameters, v *clients.ServerVersion) (*v1alpha1.GroupParameters, []clients.Capability) {
+	p := in.DeepCopy()
+	var unsupported []clients.Capability
+
+	if p.DefaultBranchProtectionDefaults != nil && !v.Supports(clients.CapabilityDefaultBranchProtectionDefaults) {
+		p.DefaultBranchProtectionDefaults = nil
+		unsupported = append(unsupported, clients.CapabilityDefaultBranchProtectionDefaults)
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.ShareWithGroupLock, g.ShareWithGroupLock) {
-		return false, nil
+
+	// Older versions only know emails_disabled, which is the inverse of
+	// emails_enabled. It is only sent to those versions.
+	//nolint:staticcheck // EmailsDisabled is needed for GitLab versions without emails_enabled
+	switch {
+	case v.Supports(clients.CapabilityEmailsEnabled):
+		p.EmailsDisabled = nil
+	case p.EmailsEnabled != nil:
+		p.EmailsDisabled = ptr.To(!*p.EmailsEnabled)
+		p.EmailsEnabled = nil
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.RequireTwoFactorAuth, g.RequireTwoFactorAuth) {
-		return false, nil
+
+	return p, unsupported
+}
+
+// reportDiff logs the group fields that differ from GitLab and records them
+// in an event.
+func (e *external) reportDiff(cr *v1alpha1.Group, diff []string) {
+	if len(diff) == 0 {
+		return
 	}
-	if !clients.IsIntEqualToIntPtr(p.TwoFactorGracePeriod, g.TwoFactorGracePeriod) {
-		return false, nil
+	e.logger.Debug("Group is not up to date", "name", cr.GetName(), "fields", diff)
+	e.recorder.Event(cr, event.Normal(reasonNotUpToDate, "Fields differ from GitLab: "+strings.Join(diff, ", ")))
+}
+
+// groupDiff returns the names of the modifiable fields whose desired value
+// differs from the observed group.
+func groupDiff(p *v1alpha1.GroupParameters, g *gitlab.Group) ([]string, error) {
+	diff := clients.Diff(p, g)
+	if !isDefaultBranchProtectionDefaultsUpToDate(p.DefaultBranchProtectionDefaults, g) {
+		diff = append(diff, "defaultBranchProtectionDefaults")
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.AutoDevopsEnabled, g.AutoDevopsEnabled) {
-		return false, nil
+	ok, err := isSharedWithGroupsUpToDate(p, g)
+	if err != nil {
+		return nil, err
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.EmailsEnabled, g.EmailsEnabled) {
-		return false, nil
+	if !ok {
+		diff = append(diff, "sharedWithGroups")
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.MentionsDisabled, g.MentionsDisabled) {
-		return false, nil
+	return diff, nil
+}
+
+// isDefaultBranchProtectionDefaultsUpToDate checks whether the default branch
+// protection defaults of the group match the spec. GitLab versions before 17.0
+// do not return default_branch_protection_defaults, in which case only the
+// deprecated defaultBranchProtection field can be reconciled.
+func isDefaultBranchProtectionDefaultsUpToDate(p *v1alpha1.DefaultBranchProtectionDefaults, g *gitlab.Group) bool {
+	if p == nil {
+		return true
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.LFSEnabled, g.LFSEnabled) {
-		return false, nil
+
+	observed := g.DefaultBranchProtectionDefaults
+	if observed.AllowedToPush == nil && observed.AllowedToMerge == nil {
+		return true
 	}
-	if !clients.IsBoolEqualToBoolPtr(p.RequestAccessEnabled, g.RequestAccessEnabled) {
-		return false, nil
+
+	if !clients.IsBoolEqualToBoolPtr(p.AllowForcePush, observed.AllowForcePush) {
+		return false
 	}
-	if !clients.IsIntEqualToIntPtr(p.ParentID, g.ParentID) {
-		return false, nil
+	if !clients.IsBoolEqualToBoolPtr(p.DeveloperCanInitialPush, observed.DeveloperCanInitialPush) {
+		return false
 	}
-	if !clients.IsIntEqualToIntPtr(p.SharedRunnersMinutesLimit, g.SharedRunnersMinutesLimit) {
-		return false, nil
+	// AllowedToMerge is not compared as the version of go-gitlab in use
+	// sends it with a malformed JSON key, so GitLab never applies it.
+	if p.AllowedToPush != nil && !isAccessLevelsEqual(p.AllowedToPush, observed.AllowedToPush) {
+		return false
 	}
-	if !clients.IsIntEqualToIntPtr(p.ExtraSharedRunnersMinutesLimit, g.ExtraSharedRunnersMinutesLimit) {
-		return false, nil
+	return true
+}
+
+func isAccessLevelsEqual(p []v1alpha1.AccessLevelValue, g []*gitlab.GroupAccessLevel) bool {
+	desired := make([]int, 0, len(p))
+	for _, l := range p {
+		desired = append(desired, int(l))
 	}
-	if ok, err := isSharedWithGroupsUpToDate(p, g); err != nil || !ok {
-		return false, err
+
+	observed := make([]int, 0, len(g))
+	for _, l := range g {
+		if l != nil && l.AccessLevel != nil {
+			observed = append(observed, int(*l.AccessLevel))
+		}
 	}
-	return true, nil
+
+	sort.Ints(desired)
+	sort.Ints(observed)
+	return cmp.Equal(desired, observed)
 }
 
 func isSharedWithGroupsUpToDate(cr *v1alpha1.GroupParameters, in *gitlab.Group) (bool, error) {
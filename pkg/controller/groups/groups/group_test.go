@@ -24,17 +24,21 @@ import (
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
 )
@@ -52,6 +56,8 @@ var (
 	expiresAt          = time.Now()
 	expiresAtIso       = (gitlab.ISOTime)(expiresAt)
 	extNameAnnotation  = map[string]string{meta.AnnotationKeyExternalName: extName}
+	ldapTrigger        = "2024-06-01T12:00:00Z"
+	ldapSyncAnnotation = map[string]string{v1alpha1.AnnotationKeyTriggerLDAPSync: ldapTrigger}
 	visibility         = "private"
 	v1alpha1Visibility = v1alpha1.VisibilityValue(visibility)
 
@@ -90,6 +96,14 @@ func withFullPathToRemove(s *string) groupModifier {
 	return func(r *v1alpha1.Group) { r.Spec.ForProvider.FullPathToRemove = s }
 }
 
+func withRemoveFinalizerOnPendingDeletion(b *bool) groupModifier {
+	return func(r *v1alpha1.Group) { r.Spec.ForProvider.RemoveFinalizerOnPendingDeletion = b }
+}
+
+func withDeletionTimestamp(t time.Time) groupModifier {
+	return func(r *v1alpha1.Group) { r.SetDeletionTimestamp(&metav1.Time{Time: t}) }
+}
+
 func withDescription(s *string) groupModifier {
 	return func(r *v1alpha1.Group) { r.Spec.ForProvider.Description = s }
 }
@@ -141,6 +155,10 @@ func withClientDefaultValues() groupModifier {
 	}
 }
 
+func withUniqueProjectDownloadLimit(i *int) groupModifier {
+	return func(r *v1alpha1.Group) { r.Spec.ForProvider.UniqueProjectDownloadLimit = i }
+}
+
 func withStatus(s v1alpha1.GroupObservation) groupModifier {
 	return func(r *v1alpha1.Group) { r.Status.AtProvider = s }
 }
@@ -149,6 +167,10 @@ func withAnnotations(a map[string]string) groupModifier {
 	return func(p *v1alpha1.Group) { meta.AddAnnotations(p, a) }
 }
 
+func withLDAPSync(o *v1alpha1.LDAPSyncObservation) groupModifier {
+	return func(r *v1alpha1.Group) { r.Status.AtProvider.LDAPSync = o }
+}
+
 func withSharedWithGroups(s []v1alpha1.SharedWithGroups) groupModifier {
 	return func(g *v1alpha1.Group) { g.Spec.ForProvider.SharedWithGroups = s }
 }
@@ -262,13 +284,44 @@ func TestObserve(t *testing.T) {
 						return &gitlab.Group{}, &gitlab.Response{}, nil
 					},
 				},
-				cr: group(withExternalName("fr")),
+				cr: group(withExternalName("/fr")),
 			},
 			want: want{
-				cr:  group(withExternalName("fr")),
+				cr:  group(withExternalName("/fr")),
 				err: errors.New(errIDNotInt),
 			},
 		},
+		"PathExternalName": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						if pid != "my-group/my-subgroup" {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return &gitlab.Group{Name: name}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withExternalName("my-group/my-subgroup"),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withConditions(xpv1.Available()),
+					withExternalName("0"),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
 		"FailedGetRequest": {
 			args: args{
 				group: &fake.MockClient{
@@ -449,6 +502,192 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"GroupSettingsDiffer": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{Name: name}, &gitlab.Response{}, nil
+					},
+					MockGetGroupSettings: func(gid interface{}, options ...gitlab.RequestOptionFunc) (*groups.GroupSettings, *gitlab.Response, error) {
+						return &groups.GroupSettings{UniqueProjectDownloadLimit: 10}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withUniqueProjectDownloadLimit(gitlab.Ptr(20)),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withUniqueProjectDownloadLimit(gitlab.Ptr(20)),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"GroupSettingsFailed": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{Name: name}, &gitlab.Response{}, nil
+					},
+					MockGetGroupSettings: func(gid interface{}, options ...gitlab.RequestOptionFunc) (*groups.GroupSettings, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withUniqueProjectDownloadLimit(gitlab.Ptr(20)),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withUniqueProjectDownloadLimit(gitlab.Ptr(20)),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+				),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LDAPSyncPending": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{Name: name}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAnnotations(ldapSyncAnnotation),
+					withLDAPSync(&v1alpha1.LDAPSyncObservation{Trigger: "previous"}),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+					withAnnotations(ldapSyncAnnotation),
+					withLDAPSync(&v1alpha1.LDAPSyncObservation{Trigger: "previous"}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"LDAPSyncDone": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{Name: name}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withAnnotations(ldapSyncAnnotation),
+					withLDAPSync(&v1alpha1.LDAPSyncObservation{Trigger: ldapTrigger}),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+					withAnnotations(ldapSyncAnnotation),
+					withLDAPSync(&v1alpha1.LDAPSyncObservation{Trigger: ldapTrigger}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
+		"PendingDeletionRemoveFinalizer": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{Name: name, MarkedForDeletionOn: &expiresAtIso}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withExternalName(extName),
+					withDeletionTimestamp(expiresAt),
+					withRemoveFinalizerOnPendingDeletion(gitlab.Ptr(true)),
+				),
+			},
+			want: want{
+				cr: group(
+					withExternalName(extName),
+					withDeletionTimestamp(expiresAt),
+					withRemoveFinalizerOnPendingDeletion(gitlab.Ptr(true)),
+				),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"PendingDeletionKeepFinalizer": {
+			args: args{
+				group: &fake.MockClient{
+					MockGetGroup: func(pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{Name: name, MarkedForDeletionOn: &expiresAtIso}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withExternalName(extName),
+					withDeletionTimestamp(expiresAt),
+				),
+			},
+			want: want{
+				cr: group(
+					withPath(""),
+					withClientDefaultValues(),
+					withConditions(xpv1.Available()),
+					withExternalName(extName),
+					withDeletionTimestamp(expiresAt),
+					withStatus(v1alpha1.GroupObservation{
+						ID:                  gitlab.Ptr(0),
+						AvatarURL:           gitlab.Ptr(""),
+						WebURL:              gitlab.Ptr(""),
+						FullName:            gitlab.Ptr(""),
+						FullPath:            gitlab.Ptr(""),
+						LDAPCN:              gitlab.Ptr(""),
+						MarkedForDeletionOn: &metav1.Time{Time: expiresAt},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+					ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte("")},
+				},
+			},
+		},
 	}
 
 	isGroupUpToDateCases := map[string]interface{}{
@@ -541,7 +780,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.group}
+			e := &external{kube: tc.kube, logger: logging.NewNopLogger(), recorder: event.NewNopRecorder(), client: tc.group}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -832,16 +1071,118 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errUpdateFailed),
 			},
 		},
+		"GroupSettingsUpdated": {
+			args: args{
+				group: &fake.MockClient{
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroupSettings: func(gid interface{}, opt *groups.GroupSettingsOptions, options ...gitlab.RequestOptionFunc) (*groups.GroupSettings, *gitlab.Response, error) {
+						if gid != groupID || opt.UniqueProjectDownloadLimit == nil || *opt.UniqueProjectDownloadLimit != 20 {
+							return nil, nil, errBoom
+						}
+						return &groups.GroupSettings{UniqueProjectDownloadLimit: 20}, &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withUniqueProjectDownloadLimit(gitlab.Ptr(20)),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withUniqueProjectDownloadLimit(gitlab.Ptr(20)),
+					withExternalName(extName),
+				),
+			},
+		},
+		"GroupSettingsUpdateFailed": {
+			args: args{
+				group: &fake.MockClient{
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+					MockUpdateGroupSettings: func(gid interface{}, opt *groups.GroupSettingsOptions, options ...gitlab.RequestOptionFunc) (*groups.GroupSettings, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withUniqueProjectDownloadLimit(gitlab.Ptr(20)),
+					withExternalName(extName),
+				),
+			},
+			want: want{
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withUniqueProjectDownloadLimit(gitlab.Ptr(20)),
+					withExternalName(extName),
+				),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+		"LDAPSyncTriggered": {
+			args: args{
+				group: &fake.MockClient{
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+					MockSyncGroupLDAP: func(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withExternalName(extName),
+					withAnnotations(ldapSyncAnnotation),
+				),
+			},
+			want: want{
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withExternalName(extName),
+					withAnnotations(ldapSyncAnnotation),
+					withLDAPSync(&v1alpha1.LDAPSyncObservation{Trigger: ldapTrigger}),
+				),
+			},
+		},
+		"LDAPSyncFailed": {
+			args: args{
+				group: &fake.MockClient{
+					MockUpdateGroup: func(pid interface{}, opt *gitlab.UpdateGroupOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Group, *gitlab.Response, error) {
+						return &gitlab.Group{ID: groupID}, &gitlab.Response{}, nil
+					},
+					MockSyncGroupLDAP: func(gid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withExternalName(extName),
+					withAnnotations(ldapSyncAnnotation),
+				),
+			},
+			want: want{
+				cr: group(
+					withStatus(v1alpha1.GroupObservation{ID: &groupID}),
+					withExternalName(extName),
+					withAnnotations(ldapSyncAnnotation),
+				),
+				err: errors.Wrap(errBoom, errLDAPSyncFailed),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.group}
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.group}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(v1alpha1.LDAPSyncObservation{}, "LastSyncTime")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
@@ -982,3 +1323,178 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDefaultBranchProtectionDefaultsUpToDate(t *testing.T) {
+	maintainer := gitlab.MaintainerPermissions
+	developer := gitlab.DeveloperPermissions
+
+	observed := &gitlab.Group{}
+	observed.DefaultBranchProtectionDefaults.AllowedToPush = []*gitlab.GroupAccessLevel{{AccessLevel: &maintainer}, {AccessLevel: &developer}}
+	observed.DefaultBranchProtectionDefaults.AllowForcePush = false
+	observed.DefaultBranchProtectionDefaults.DeveloperCanInitialPush = true
+
+	cases := map[string]struct {
+		p    *v1alpha1.DefaultBranchProtectionDefaults
+		g    *gitlab.Group
+		want bool
+	}{
+		"NotSet": {
+			p:    nil,
+			g:    observed,
+			want: true,
+		},
+		"NotSupportedByServer": {
+			p: &v1alpha1.DefaultBranchProtectionDefaults{
+				AllowForcePush: gitlab.Ptr(true),
+			},
+			g:    &gitlab.Group{},
+			want: true,
+		},
+		"UpToDate": {
+			p: &v1alpha1.DefaultBranchProtectionDefaults{
+				AllowedToPush:           []v1alpha1.AccessLevelValue{v1alpha1.DeveloperPermissions, v1alpha1.MaintainerPermissions},
+				AllowForcePush:          gitlab.Ptr(false),
+				DeveloperCanInitialPush: gitlab.Ptr(true),
+			},
+			g:    observed,
+			want: true,
+		},
+		"AllowedToPushChanged": {
+			p: &v1alpha1.DefaultBranchProtectionDefaults{
+				AllowedToPush: []v1alpha1.AccessLevelValue{v1alpha1.MaintainerPermissions},
+			},
+			g:    observed,
+			want: false,
+		},
+		"AllowForcePushChanged": {
+			p: &v1alpha1.DefaultBranchProtectionDefaults{
+				AllowForcePush: gitlab.Ptr(true),
+			},
+			g:    observed,
+			want: false,
+		},
+		"DeveloperCanInitialPushChanged": {
+			p: &v1alpha1.DefaultBranchProtectionDefaults{
+				DeveloperCanInitialPush: gitlab.Ptr(false),
+			},
+			g:    observed,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isDefaultBranchProtectionDefaultsUpToDate(tc.p, tc.g)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSupportedParameters(t *testing.T) {
+	protection := &v1alpha1.DefaultBranchProtectionDefaults{AllowForcePush: gitlab.Ptr(false)}
+
+	type want struct {
+		p           *v1alpha1.GroupParameters
+		unsupported []clients.Capability
+	}
+
+	cases := map[string]struct {
+		p       *v1alpha1.GroupParameters
+		version *clients.ServerVersion
+		want    want
+	}{
+		"UnknownVersion": {
+			p:       &v1alpha1.GroupParameters{EmailsEnabled: gitlab.Ptr(true), DefaultBranchProtectionDefaults: protection},
+			version: nil,
+			want: want{
+				p: &v1alpha1.GroupParameters{EmailsEnabled: gitlab.Ptr(true), DefaultBranchProtectionDefaults: protection},
+			},
+		},
+		"AllSupported": {
+			p:       &v1alpha1.GroupParameters{EmailsEnabled: gitlab.Ptr(true), DefaultBranchProtectionDefaults: protection},
+			version: &clients.ServerVersion{Major: 17, Minor: 2},
+			want: want{
+				p: &v1alpha1.GroupParameters{EmailsEnabled: gitlab.Ptr(true), DefaultBranchProtectionDefaults: protection},
+			},
+		},
+		"DefaultBranchProtectionDefaultsUnsupported": {
+			p:       &v1alpha1.GroupParameters{EmailsEnabled: gitlab.Ptr(true), DefaultBranchProtectionDefaults: protection},
+			version: &clients.ServerVersion{Major: 16, Minor: 11},
+			want: want{
+				p:           &v1alpha1.GroupParameters{EmailsEnabled: gitlab.Ptr(true)},
+				unsupported: []clients.Capability{clients.CapabilityDefaultBranchProtectionDefaults},
+			},
+		},
+		"EmailsEnabledUnsupported": {
+			p:       &v1alpha1.GroupParameters{EmailsEnabled: gitlab.Ptr(true)},
+			version: &clients.ServerVersion{Major: 16, Minor: 4},
+			want: want{
+				p: &v1alpha1.GroupParameters{EmailsDisabled: gitlab.Ptr(false)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, unsupported := supportedParameters(tc.p, tc.version)
+			if diff := cmp.Diff(tc.want.p, p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.unsupported, unsupported); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupDiff(t *testing.T) {
+	observed := &gitlab.Group{
+		Name:           name,
+		Path:           path,
+		Description:    "description",
+		MembershipLock: false,
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.GroupParameters
+		want []string
+	}{
+		"UpToDate": {
+			p: &v1alpha1.GroupParameters{
+				Name:        &name,
+				Path:        path,
+				Description: gitlab.Ptr("description"),
+			},
+		},
+		"FieldsDiffer": {
+			p: &v1alpha1.GroupParameters{
+				Name:           gitlab.Ptr("other-name"),
+				Path:           path,
+				Description:    gitlab.Ptr("other description"),
+				MembershipLock: gitlab.Ptr(true),
+			},
+			want: []string{"description", "name", "membershipLock"},
+		},
+		"PreventForkingOutsideGroupDiffers": {
+			p: &v1alpha1.GroupParameters{
+				Path:                       path,
+				PreventForkingOutsideGroup: gitlab.Ptr(true),
+			},
+			want: []string{"preventForkingOutsideGroup"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := groupDiff(tc.p, observed)
+			if err != nil {
+				t.Fatalf("groupDiff(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -34,6 +34,9 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -59,12 +62,14 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{
 			kube:              mgr.GetClient(),
 			newGitlabClientFn: groups.NewMemberClient,
-			newUserClientFn:   users.NewUserClient}),
+			newUserClientFn:   users.NewUserClient,
+			newGroupGetterFn:  groups.NewGroupGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -93,6 +98,7 @@ type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg clients.Config) groups.MemberClient
 	newUserClientFn   func(cfg clients.Config) users.UserClient
+	newGroupGetterFn  func(cfg clients.Config) groups.GroupGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -104,6 +110,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
+	if cr.Spec.ForProvider.GroupID == nil && cr.Spec.ForProvider.GroupPath != nil {
+		id, err := groups.ResolveGroupPath(ctx, c.newGroupGetterFn(*cfg), *cr.Spec.ForProvider.GroupPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.GroupID = &id
+	}
 	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), userClient: c.newUserClientFn(*cfg)}, nil
 }
 
@@ -37,6 +37,9 @@ import (
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -45,6 +48,7 @@ const (
 	errGetFailed              = "cannot get Gitlab SamlGroupLink"
 	errCreateFailed           = "cannot create Gitlab SamlGroupLink"
 	errDeleteFailed           = "cannot delete Gitlab SamlGroupLink"
+	errUpdateFailed           = "cannot update Gitlab SamlGroupLink"
 	errSamlGroupLinktNotFound = "cannot find Gitlab SamlGroupLink"
 	errMissingGroupID         = "missing Spec.ForProvider.GroupID"
 	errMissingExternalName    = "external name annotation not found"
@@ -60,9 +64,10 @@ func SetupSamlGroupLink(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewSamlGroupLinkClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewSamlGroupLinkClient, newGroupGetterFn: groups.NewGroupGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -89,6 +94,7 @@ func SetupSamlGroupLink(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg clients.Config) groups.SamlGroupLinkClient
+	newGroupGetterFn  func(cfg clients.Config) groups.GroupGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -101,6 +107,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
+	if cr.Spec.ForProvider.GroupID == nil && cr.Spec.ForProvider.GroupPath != nil {
+		id, err := groups.ResolveGroupPath(ctx, c.newGroupGetterFn(*cfg), *cr.Spec.ForProvider.GroupPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.GroupID = &id
+	}
 	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
@@ -166,8 +179,31 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// not able to update SamlGroupLink
-	return managed.ExternalUpdate{}, nil
+	cr, ok := mg.(*v1alpha1.SamlGroupLink)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSamlGroupLink)
+	}
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errMissingGroupID)
+	}
+
+	samlGroupName := meta.GetExternalName(cr)
+	if samlGroupName == "" {
+		return managed.ExternalUpdate{}, errors.New(errMissingExternalName)
+	}
+
+	// SAML group links cannot be edited in place, so drift is corrected by
+	// recreating the link with the desired access level and member role.
+	if _, err := e.client.DeleteGroupSAMLLink(*cr.Spec.ForProvider.GroupID, samlGroupName, gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+
+	_, _, err := e.client.AddGroupSAMLLink(
+		*cr.Spec.ForProvider.GroupID,
+		groups.GenerateAddSamlGroupLinkOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -207,5 +243,10 @@ func isSamlGroupLinkUpToDate(p *v1alpha1.SamlGroupLinkParameters, g *gitlab.SAML
 	if !cmp.Equal(*p.Name, g.Name) {
 		return false
 	}
-	return true
+
+	memberRoleID := 0
+	if p.MemberRoleID != nil {
+		memberRoleID = *p.MemberRoleID
+	}
+	return memberRoleID == g.MemberRoleID
 }
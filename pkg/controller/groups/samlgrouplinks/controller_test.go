@@ -18,7 +18,6 @@ package samlgrouplinks
 
 import (
 	"context"
-	"net/http"
 	"testing"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -29,7 +28,6 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
@@ -37,40 +35,36 @@ import (
 )
 
 var (
-	unexpecedItem resource.Managed
-
-	errBoom     = errors.New("boom")
-	name        = "Saml-example"
-	groupID     = 1234
-	accessLevel = gitlab.AccessLevelValue(10)
+	errBoom       = errors.New("boom")
+	groupID       = 1234
+	samlGroupName = "saml-group"
+	memberRoleID  = 42
 )
 
-type SamlGroupLinkModifier func(*v1alpha1.SamlGroupLink)
-
-func withConditions(c ...xpv1.Condition) SamlGroupLinkModifier {
-	return func(cr *v1alpha1.SamlGroupLink) { cr.Status.ConditionedStatus.Conditions = c }
-}
-func withGroupID() SamlGroupLinkModifier {
-	return func(r *v1alpha1.SamlGroupLink) { r.Spec.ForProvider.GroupID = &groupID }
+type args struct {
+	samlGroupLink groups.SamlGroupLinkClient
+	cr            resource.Managed
 }
 
-func withStatus(s v1alpha1.SamlGroupLinkObservation) SamlGroupLinkModifier {
-	return func(r *v1alpha1.SamlGroupLink) { r.Status.AtProvider = s }
+type samlGroupLinkModifier func(*v1alpha1.SamlGroupLink)
+
+func withConditions(c ...xpv1.Condition) samlGroupLinkModifier {
+	return func(r *v1alpha1.SamlGroupLink) { r.Status.ConditionedStatus.Conditions = c }
 }
 
-func withAccessLevel(i int) SamlGroupLinkModifier {
-	return func(r *v1alpha1.SamlGroupLink) { r.Spec.ForProvider.AccessLevel = v1alpha1.AccessLevelValue(i) }
+func withSpec(fp v1alpha1.SamlGroupLinkParameters) samlGroupLinkModifier {
+	return func(r *v1alpha1.SamlGroupLink) { r.Spec.ForProvider = fp }
 }
 
-func withSpec(s v1alpha1.SamlGroupLinkParameters) SamlGroupLinkModifier {
-	return func(r *v1alpha1.SamlGroupLink) { r.Spec.ForProvider = s }
+func withStatus(s v1alpha1.SamlGroupLinkObservation) samlGroupLinkModifier {
+	return func(r *v1alpha1.SamlGroupLink) { r.Status.AtProvider = s }
 }
 
-func withExternalName(n string) SamlGroupLinkModifier {
-	return func(r *v1alpha1.SamlGroupLink) { meta.SetExternalName(r, n) }
+func withExternalName(name string) samlGroupLinkModifier {
+	return func(r *v1alpha1.SamlGroupLink) { meta.SetExternalName(r, name) }
 }
 
-func samlGroupLink(m ...SamlGroupLinkModifier) *v1alpha1.SamlGroupLink {
+func samlGroupLink(m ...samlGroupLinkModifier) *v1alpha1.SamlGroupLink {
 	cr := &v1alpha1.SamlGroupLink{}
 	for _, f := range m {
 		f(cr)
@@ -78,56 +72,12 @@ func samlGroupLink(m ...SamlGroupLinkModifier) *v1alpha1.SamlGroupLink {
 	return cr
 }
 
-type args struct {
-	samlGroupLink groups.SamlGroupLinkClient
-	kube          client.Client
-	cr            resource.Managed
-}
-
-func TestConnect(t *testing.T) {
-	type want struct {
-		cr     resource.Managed
-		result managed.ExternalClient
-		err    error
-	}
-
-	cases := map[string]struct {
-		args
-		want
-	}{
-		"InValidInput": {
-			args: args{
-				cr: unexpecedItem,
-			},
-			want: want{
-				cr:  unexpecedItem,
-				err: errors.New(errNotSamlGroupLink),
-			},
-		},
-		"ProviderConfigRefNotGivenError": {
-			args: args{
-				cr:   samlGroupLink(),
-				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
-			},
-			want: want{
-				cr:  samlGroupLink(),
-				err: errors.New("providerConfigRef is not given"),
-			},
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			c := &connector{kube: tc.kube, newGitlabClientFn: nil}
-			o, err := c.Connect(context.Background(), tc.args.cr)
-
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-			if diff := cmp.Diff(tc.want.result, o); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-		})
+func params(memberRoleID *int) v1alpha1.SamlGroupLinkParameters {
+	return v1alpha1.SamlGroupLinkParameters{
+		GroupID:      &groupID,
+		Name:         &samlGroupName,
+		AccessLevel:  v1alpha1.AccessLevelValue(gitlab.DeveloperPermissions),
+		MemberRoleID: memberRoleID,
 	}
 }
 
@@ -142,125 +92,68 @@ func TestObserve(t *testing.T) {
 		args
 		want
 	}{
-		"InValidInput": {
-			args: args{
-				cr: unexpecedItem,
-			},
-			want: want{
-				cr:  unexpecedItem,
-				err: errors.New(errNotSamlGroupLink),
-			},
-		},
-		"NoExternalName": {
-			args: args{
-				cr: samlGroupLink(),
-			},
-			want: want{
-				cr:     samlGroupLink(),
-				err:    nil,
-				result: managed.ExternalObservation{ResourceExists: false},
-			},
-		},
-		"NoGroupID": {
-			args: args{
-				cr: samlGroupLink(
-					withExternalName(name),
-				),
-			},
-			want: want{
-				cr: samlGroupLink(
-					withExternalName(name),
-				),
-				err:    errors.New(errMissingGroupID),
-				result: managed.ExternalObservation{},
-			},
-		},
-		"FailedGetRequest": {
+		"UpToDateWithMemberRole": {
 			args: args{
 				samlGroupLink: &fake.MockClient{
-					MockGetGroupSAMLLink: func(gid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
-						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					MockGetGroupSAMLLink: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
+						return &gitlab.SAMLGroupLink{Name: samlGroupName, AccessLevel: gitlab.DeveloperPermissions, MemberRoleID: memberRoleID}, nil, nil
 					},
 				},
-				cr: samlGroupLink(
-					withGroupID(),
-					withAccessLevel(10),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-				),
+				cr: samlGroupLink(withSpec(params(&memberRoleID)), withExternalName(samlGroupName)),
 			},
 			want: want{
 				cr: samlGroupLink(
-					withGroupID(),
-					withAccessLevel(10),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
+					withSpec(params(&memberRoleID)),
+					withExternalName(samlGroupName),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.SamlGroupLinkObservation{Name: samlGroupName, MemberRoleID: memberRoleID}),
 				),
-				result: managed.ExternalObservation{ResourceExists: false},
-				err:    errors.Wrap(errBoom, errGetFailed),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
 			},
 		},
-		"ErrGet404": {
+		"MemberRoleReverted": {
 			args: args{
 				samlGroupLink: &fake.MockClient{
-					MockGetGroupSAMLLink: func(gid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
-						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errors.New("Linked SAML group link not found")
+					MockGetGroupSAMLLink: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
+						return &gitlab.SAMLGroupLink{Name: samlGroupName, AccessLevel: gitlab.DeveloperPermissions}, nil, nil
 					},
 				},
-				cr: samlGroupLink(
-					withGroupID(),
-					withExternalName(name),
-					withAccessLevel(10),
-
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-				),
+				cr: samlGroupLink(withSpec(params(&memberRoleID)), withExternalName(samlGroupName)),
 			},
 			want: want{
 				cr: samlGroupLink(
-					withGroupID(),
-					withExternalName(name),
-					withAccessLevel(10),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
+					withSpec(params(&memberRoleID)),
+					withExternalName(samlGroupName),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.SamlGroupLinkObservation{Name: samlGroupName}),
 				),
-				result: managed.ExternalObservation{ResourceExists: false},
-				err:    nil,
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
 			},
 		},
-		"SuccessfulAvailable": {
+		"UnexpectedMemberRole": {
 			args: args{
 				samlGroupLink: &fake.MockClient{
-					MockGetGroupSAMLLink: func(gid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
-						return &gitlab.SAMLGroupLink{Name: name, AccessLevel: accessLevel}, &gitlab.Response{}, nil
+					MockGetGroupSAMLLink: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
+						return &gitlab.SAMLGroupLink{Name: samlGroupName, AccessLevel: gitlab.DeveloperPermissions, MemberRoleID: memberRoleID}, nil, nil
 					},
 				},
-				cr: samlGroupLink(
-					withGroupID(),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
+				cr: samlGroupLink(withSpec(params(nil)), withExternalName(samlGroupName)),
 			},
 			want: want{
 				cr: samlGroupLink(
+					withSpec(params(nil)),
+					withExternalName(samlGroupName),
 					withConditions(xpv1.Available()),
-					withGroupID(),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-					withStatus(v1alpha1.SamlGroupLinkObservation{Name: name}),
+					withStatus(v1alpha1.SamlGroupLinkObservation{Name: samlGroupName, MemberRoleID: memberRoleID}),
 				),
-				result: managed.ExternalObservation{
-					ResourceExists:          true,
-					ResourceUpToDate:        true,
-					ResourceLateInitialized: false,
-				},
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
 			},
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.samlGroupLink}
+			e := &external{client: tc.samlGroupLink}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -274,204 +167,75 @@ func TestObserve(t *testing.T) {
 			}
 		})
 	}
-
 }
 
-func TestDelete(t *testing.T) {
+func TestUpdate(t *testing.T) {
 	type want struct {
-		cr  resource.Managed
-		err error
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
 	}
 
 	cases := map[string]struct {
 		args
 		want
 	}{
-		"InValidInput": {
-			args: args{
-				cr: unexpecedItem,
-			},
-			want: want{
-				cr:  unexpecedItem,
-				err: errors.New(errNotSamlGroupLink),
-			},
-		},
-		"NoExternalName": {
-			args: args{
-				cr: samlGroupLink(
-					withGroupID(),
-				),
-			},
-			want: want{
-				cr: samlGroupLink(
-					withGroupID(),
-				),
-				err: errors.New(errMissingExternalName),
-			},
-		},
-		"NoGroupID": {
-			args: args{
-				cr: samlGroupLink(
-					withExternalName(name),
-				),
-			},
-			want: want{
-				cr: samlGroupLink(
-					withExternalName(name),
-				),
-				err: errors.New(errMissingGroupID),
-			},
-		},
-		"FailedDeletion": {
+		"RecreatesLink": {
 			args: args{
 				samlGroupLink: &fake.MockClient{
-					MockDeleteGroupSAMLLink: func(pid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
-						return &gitlab.Response{}, errBoom
+					MockDeleteGroupSAMLLink: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, nil
 					},
-				},
-				cr: samlGroupLink(
-					withGroupID(),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
-			},
-			want: want{
-				cr: samlGroupLink(
-					withGroupID(),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
-				err: errors.Wrap(errBoom, errDeleteFailed),
-			},
-		},
-		"SuccessfulDeletion": {
-			args: args{
-				samlGroupLink: &fake.MockClient{
-					MockDeleteGroupSAMLLink: func(pid interface{}, samlGroupName string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
-						return &gitlab.Response{}, nil
+					MockAddGroupSAMLLink: func(pid interface{}, opt *gitlab.AddGroupSAMLLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
+						if opt.MemberRoleID == nil || *opt.MemberRoleID != memberRoleID {
+							return nil, nil, errBoom
+						}
+						return &gitlab.SAMLGroupLink{Name: samlGroupName, MemberRoleID: memberRoleID}, nil, nil
 					},
 				},
-				cr: samlGroupLink(
-					withGroupID(),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
+				cr: samlGroupLink(withSpec(params(&memberRoleID)), withExternalName(samlGroupName)),
 			},
 			want: want{
-				cr: samlGroupLink(
-					withGroupID(),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
-				err: nil,
+				cr: samlGroupLink(withSpec(params(&memberRoleID)), withExternalName(samlGroupName)),
 			},
 		},
-	}
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.samlGroupLink}
-			_, err := e.Delete(context.Background(), tc.args.cr)
-
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-		})
-	}
-}
-
-func TestCreate(t *testing.T) {
-	type want struct {
-		cr     resource.Managed
-		result managed.ExternalCreation
-		err    error
-	}
-
-	cases := map[string]struct {
-		args
-		want
-	}{
-		"InValidInput": {
-			args: args{
-				cr: unexpecedItem,
-			},
-			want: want{
-				cr:  unexpecedItem,
-				err: errors.New(errNotSamlGroupLink),
-			},
-		},
-		"NoGroupID": {
-			args: args{
-				cr: samlGroupLink(
-					withExternalName(name),
-				),
-			},
-			want: want{
-				cr: samlGroupLink(
-					withExternalName(name),
-				),
-				err:    errors.New(errMissingGroupID),
-				result: managed.ExternalCreation{},
-			},
-		},
-		"FailedCreation": {
+		"FailedDelete": {
 			args: args{
 				samlGroupLink: &fake.MockClient{
-					MockAddGroupSAMLLink: func(pid interface{}, opt *gitlab.AddGroupSAMLLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
-						return &gitlab.SAMLGroupLink{}, &gitlab.Response{}, errBoom
+					MockDeleteGroupSAMLLink: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, errBoom
 					},
 				},
-				cr: samlGroupLink(
-					withGroupID(),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
+				cr: samlGroupLink(withSpec(params(&memberRoleID)), withExternalName(samlGroupName)),
 			},
 			want: want{
-				cr: samlGroupLink(
-					withGroupID(),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
-				err: errors.Wrap(errBoom, errCreateFailed),
+				cr:  samlGroupLink(withSpec(params(&memberRoleID)), withExternalName(samlGroupName)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
 			},
 		},
-		"SuccessfulCreation": {
+		"FailedAdd": {
 			args: args{
 				samlGroupLink: &fake.MockClient{
+					MockDeleteGroupSAMLLink: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, nil
+					},
 					MockAddGroupSAMLLink: func(pid interface{}, opt *gitlab.AddGroupSAMLLinkOptions, options ...gitlab.RequestOptionFunc) (*gitlab.SAMLGroupLink, *gitlab.Response, error) {
-						return &gitlab.SAMLGroupLink{Name: name}, &gitlab.Response{}, nil
+						return nil, nil, errBoom
 					},
 				},
-				cr: samlGroupLink(
-					withGroupID(),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
+				cr: samlGroupLink(withSpec(params(&memberRoleID)), withExternalName(samlGroupName)),
 			},
 			want: want{
-				cr: samlGroupLink(
-					withGroupID(),
-					withExternalName(name),
-					withSpec(v1alpha1.SamlGroupLinkParameters{GroupID: &groupID, Name: &name}),
-					withAccessLevel(10),
-				),
-				err:    nil,
-				result: managed.ExternalCreation{},
+				cr:  samlGroupLink(withSpec(params(&memberRoleID)), withExternalName(samlGroupName)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
 			},
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.samlGroupLink}
-			o, err := e.Create(context.Background(), tc.args.cr)
+			e := &external{client: tc.samlGroupLink}
+			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
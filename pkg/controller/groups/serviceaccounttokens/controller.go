@@ -0,0 +1,292 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttokens
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+)
+
+const (
+	errNotServiceAccountToken = "managed resource is not a Gitlab service account token custom resource"
+	errExternalNameNotInt     = "custom resource external name is not an integer"
+	errGetFailed              = "cannot get Gitlab service account token"
+	errCreateFailed           = "cannot create Gitlab service account token"
+	errRotateFailed           = "cannot rotate Gitlab service account token"
+	errKubeUpdateFailed       = "cannot update Gitlab service account token custom resource"
+	errDeleteFailed           = "cannot delete Gitlab service account token"
+	errMissingGroupID         = "missing Spec.ForProvider.GroupID"
+	errExpiresAtInPast        = "Spec.ForProvider.ExpiresAt must be in the future"
+
+	reasonRotated event.Reason = "RotatedServiceAccountToken"
+)
+
+// SetupServiceAccountToken adds a controller that reconciles
+// ServiceAccountTokens.
+func SetupServiceAccountToken(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ServiceAccountTokenKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: groups.NewServiceAccountTokenClient, newGroupGetterFn: groups.NewGroupGetter}))),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ServiceAccountTokenGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.ServiceAccountTokenList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ServiceAccountToken{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	recorder          event.Recorder
+	newGitlabClientFn func(cfg clients.Config) groups.ServiceAccountTokenClient
+	newGroupGetterFn  func(cfg clients.Config) groups.GroupGetter
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountToken)
+	if !ok {
+		return nil, errors.New(errNotServiceAccountToken)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	if cr.Spec.ForProvider.GroupID == nil && cr.Spec.ForProvider.GroupPath != nil {
+		id, err := groups.ResolveGroupPath(ctx, c.newGroupGetterFn(*cfg), *cr.Spec.ForProvider.GroupPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.GroupID = &id
+	}
+	return &external{kube: c.kube, recorder: c.recorder, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube     client.Client
+	recorder event.Recorder
+	client   groups.ServiceAccountTokenClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceAccountToken)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	tokenID, err := externalname.ParseID(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errExternalNameNotInt)
+	}
+
+	pat, res, err := e.client.GetServiceAccountPersonalAccessToken(tokenID, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// A token revoked outside of Crossplane is replaced by a new one, which
+	// is published to the connection secret.
+	if pat.Revoked {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitializeServiceAccountToken(&cr.Spec.ForProvider, pat)
+
+	cr.Status.AtProvider = groups.GenerateServiceAccountTokenObservation(pat)
+	cr.Status.SetConditions(xpv1.Available(), clients.TokenExpiring(pat.ExpiresAt, cr.Spec.ForProvider.ExpiryWarningDays, time.Now()))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        !groups.IsServiceAccountTokenExpiring(&cr.Spec.ForProvider, pat, time.Now()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceAccountToken)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalCreation{}, errors.New(errMissingGroupID)
+	}
+
+	// CEL has no notion of the current time, so this can't be validated by
+	// the API server.
+	if at := cr.Spec.ForProvider.ExpiresAt; at != nil && !at.After(time.Now()) {
+		return managed.ExternalCreation{}, errors.New(errExpiresAtInPast)
+	}
+
+	pat, _, err := e.client.CreateServiceAccountPersonalAccessToken(
+		*cr.Spec.ForProvider.GroupID,
+		cr.Spec.ForProvider.ServiceAccountID,
+		groups.GenerateCreateServiceAccountTokenOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, externalname.FormatID(pat.ID))
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			"token": []byte(pat.Token),
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceAccountToken)
+	}
+
+	// A service account token can't be updated, it can only be rotated
+	// according to its expiresAt policy.
+	if cr.Spec.ForProvider.ExpiresAtPolicy == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	oldID, err := externalname.ParseID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errExternalNameNotInt)
+	}
+
+	if cr.Spec.ForProvider.GroupID == nil {
+		return managed.ExternalUpdate{}, errors.New(errMissingGroupID)
+	}
+
+	// GitLab revokes the old token and returns a new one with a new ID.
+	pat, _, err := e.client.RotateServiceAccountPersonalAccessToken(
+		*cr.Spec.ForProvider.GroupID,
+		cr.Spec.ForProvider.ServiceAccountID,
+		oldID,
+		groups.GenerateRotateServiceAccountTokenOptions(&cr.Spec.ForProvider, time.Now()),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotateFailed)
+	}
+
+	meta.SetExternalName(cr, externalname.FormatID(pat.ID))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+
+	e.recorder.Event(cr, event.Normal(reasonRotated, fmt.Sprintf(
+		"Service account token %d was rotated to %d because it was about to expire", oldID, pat.ID)))
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			"token": []byte(pat.Token),
+		},
+	}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountToken)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotServiceAccountToken)
+	}
+
+	tokenID, err := externalname.ParseID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errors.New(errExternalNameNotInt)
+	}
+
+	_, err = e.client.RevokeServiceAccountPersonalAccessToken(tokenID, gitlab.WithContext(ctx))
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// lateInitializeServiceAccountToken fills the empty fields in the service
+// account token spec with the values seen in gitlab.
+func lateInitializeServiceAccountToken(in *v1alpha1.ServiceAccountTokenParameters, pat *gitlab.PersonalAccessToken) {
+	if pat == nil {
+		return
+	}
+
+	if in.ExpiresAt == nil && in.ExpiresAtPolicy == nil && pat.ExpiresAt != nil {
+		in.ExpiresAt = &metav1.Time{Time: time.Time(*pat.ExpiresAt)}
+	}
+}
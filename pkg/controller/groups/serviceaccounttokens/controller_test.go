@@ -0,0 +1,498 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttokens
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups/fake"
+)
+
+var (
+	errBoom          = errors.New("boom")
+	groupID          = 1
+	serviceAccountID = 42
+	tokenID          = 1234
+	sTokenID         = strconv.Itoa(tokenID)
+	invalidInput     resource.Managed
+	expiresAt        = time.Now().AddDate(0, 6, 0).Truncate(24 * time.Hour)
+	expiresSoon      = time.Now().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+	name             = "deploy-bot"
+	token            = "Token"
+	rotatePolicy     = v1alpha1.ExpiresAtPolicy{
+		RotateDaysBefore: 7,
+		ValidityDays:     90,
+	}
+)
+
+type args struct {
+	client groups.ServiceAccountTokenClient
+	kube   client.Client
+	cr     resource.Managed
+}
+
+type serviceAccountTokenModifier func(*v1alpha1.ServiceAccountToken)
+
+func withConditions(c ...xpv1.Condition) serviceAccountTokenModifier {
+	return func(r *v1alpha1.ServiceAccountToken) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.ServiceAccountTokenParameters) serviceAccountTokenModifier {
+	return func(r *v1alpha1.ServiceAccountToken) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(o v1alpha1.ServiceAccountTokenObservation) serviceAccountTokenModifier {
+	return func(r *v1alpha1.ServiceAccountToken) { r.Status.AtProvider = o }
+}
+
+func withExternalName(n string) serviceAccountTokenModifier {
+	return func(r *v1alpha1.ServiceAccountToken) { meta.SetExternalName(r, n) }
+}
+
+func serviceAccountToken(m ...serviceAccountTokenModifier) *v1alpha1.ServiceAccountToken {
+	cr := &v1alpha1.ServiceAccountToken{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*v1alpha1.ServiceAccountTokenParameters)) v1alpha1.ServiceAccountTokenParameters {
+	p := v1alpha1.ServiceAccountTokenParameters{
+		GroupID:          &groupID,
+		ServiceAccountID: serviceAccountID,
+		Name:             name,
+		Scopes:           []string{"api"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func pat(expires time.Time, revoked bool) *gitlab.PersonalAccessToken {
+	return &gitlab.PersonalAccessToken{
+		ID:        tokenID,
+		Name:      name,
+		UserID:    serviceAccountID,
+		Scopes:    []string{"api"},
+		ExpiresAt: (*gitlab.ISOTime)(&expires),
+		Active:    !revoked,
+		Revoked:   revoked,
+		Token:     token,
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	notExpiring := xpv1.Condition{
+		Type:   clients.TypeTokenExpiring,
+		Status: corev1.ConditionFalse,
+		Reason: clients.ReasonTokenNotExpiring,
+	}
+	expiring := xpv1.Condition{
+		Type:    clients.TypeTokenExpiring,
+		Status:  corev1.ConditionTrue,
+		Reason:  clients.ReasonTokenExpiring,
+		Message: "Access token expires at " + expiresSoon.Format(time.RFC3339),
+	}
+	withPolicy := func(p *v1alpha1.ServiceAccountTokenParameters) { p.ExpiresAtPolicy = &rotatePolicy }
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotServiceAccountToken),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: serviceAccountToken(),
+			},
+			want: want{
+				cr: serviceAccountToken(),
+			},
+		},
+		"ExternalNameNotInt": {
+			args: args{
+				cr: serviceAccountToken(withExternalName("fr")),
+			},
+			want: want{
+				cr:  serviceAccountToken(withExternalName("fr")),
+				err: errors.New(errExternalNameNotInt),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetServiceAccountPersonalAccessToken: func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: serviceAccountToken(withExternalName(sTokenID), withSpec(params())),
+			},
+			want: want{
+				cr:  serviceAccountToken(withExternalName(sTokenID), withSpec(params())),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetServiceAccountPersonalAccessToken: func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: serviceAccountToken(withExternalName(sTokenID), withSpec(params())),
+			},
+			want: want{
+				cr: serviceAccountToken(withExternalName(sTokenID), withSpec(params())),
+			},
+		},
+		"Revoked": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetServiceAccountPersonalAccessToken: func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return pat(expiresAt, true), &gitlab.Response{}, nil
+					},
+				},
+				cr: serviceAccountToken(withExternalName(sTokenID), withSpec(params())),
+			},
+			want: want{
+				cr: serviceAccountToken(withExternalName(sTokenID), withSpec(params())),
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetServiceAccountPersonalAccessToken: func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return pat(expiresAt, false), &gitlab.Response{}, nil
+					},
+				},
+				cr: serviceAccountToken(withExternalName(sTokenID), withSpec(params())),
+			},
+			want: want{
+				cr: serviceAccountToken(
+					withExternalName(sTokenID),
+					withSpec(params(func(p *v1alpha1.ServiceAccountTokenParameters) {
+						p.ExpiresAt = &metav1.Time{Time: expiresAt}
+					})),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.ServiceAccountTokenObservation{
+						TokenID:   &tokenID,
+						UserID:    &serviceAccountID,
+						ExpiresAt: &metav1.Time{Time: expiresAt},
+						Active:    true,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ExpiringWithPolicy": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetServiceAccountPersonalAccessToken: func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return pat(expiresSoon, false), &gitlab.Response{}, nil
+					},
+				},
+				cr: serviceAccountToken(withExternalName(sTokenID), withSpec(params(withPolicy))),
+			},
+			want: want{
+				cr: serviceAccountToken(
+					withExternalName(sTokenID),
+					withSpec(params(withPolicy)),
+					withConditions(xpv1.Available(), expiring),
+					withStatus(v1alpha1.ServiceAccountTokenObservation{
+						TokenID:   &tokenID,
+						UserID:    &serviceAccountID,
+						ExpiresAt: &metav1.Time{Time: expiresSoon},
+						Active:    true,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: invalidInput,
+			},
+			want: want{
+				cr:  invalidInput,
+				err: errors.New(errNotServiceAccountToken),
+			},
+		},
+		"NoGroupID": {
+			args: args{
+				cr: serviceAccountToken(),
+			},
+			want: want{
+				cr:  serviceAccountToken(),
+				err: errors.New(errMissingGroupID),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateServiceAccountPersonalAccessToken: func(gid interface{}, serviceAccount int, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: serviceAccountToken(withSpec(params())),
+			},
+			want: want{
+				cr:  serviceAccountToken(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateServiceAccountPersonalAccessToken: func(gid interface{}, serviceAccount int, opt *gitlab.CreateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						if serviceAccount != serviceAccountID {
+							return nil, nil, errBoom
+						}
+						return pat(expiresAt, false), &gitlab.Response{}, nil
+					},
+				},
+				cr: serviceAccountToken(withSpec(params())),
+			},
+			want: want{
+				cr: serviceAccountToken(withSpec(params()), withExternalName(sTokenID)),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	withPolicy := func(p *v1alpha1.ServiceAccountTokenParameters) { p.ExpiresAtPolicy = &rotatePolicy }
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoPolicy": {
+			args: args{
+				cr: serviceAccountToken(withSpec(params())),
+			},
+			want: want{
+				cr: serviceAccountToken(withSpec(params())),
+			},
+		},
+		"RotateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockRotateServiceAccountPersonalAccessToken: func(gid interface{}, serviceAccount, token int, opt *groups.RotateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: serviceAccountToken(withExternalName("1"), withSpec(params(withPolicy))),
+			},
+			want: want{
+				cr:  serviceAccountToken(withExternalName("1"), withSpec(params(withPolicy))),
+				err: errors.Wrap(errBoom, errRotateFailed),
+			},
+		},
+		"SuccessfulRotate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockClient{
+					MockRotateServiceAccountPersonalAccessToken: func(gid interface{}, serviceAccount, token int, opt *groups.RotateServiceAccountPersonalAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+						if opt.ExpiresAt == nil {
+							return nil, nil, errBoom
+						}
+						return pat(expiresAt, false), &gitlab.Response{}, nil
+					},
+				},
+				cr: serviceAccountToken(withExternalName("1"), withSpec(params(withPolicy))),
+			},
+			want: want{
+				cr: serviceAccountToken(withExternalName(sTokenID), withSpec(params(withPolicy))),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameNotInt": {
+			args: args{
+				cr: serviceAccountToken(withExternalName("fr")),
+			},
+			want: want{
+				err: errors.New(errExternalNameNotInt),
+			},
+		},
+		"RevokeFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockRevokeServiceAccountPersonalAccessToken: func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return nil, errBoom
+					},
+				},
+				cr: serviceAccountToken(withExternalName(sTokenID)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				client: &fake.MockClient{
+					MockRevokeServiceAccountPersonalAccessToken: func(token int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: serviceAccountToken(withExternalName(sTokenID)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,10 +22,13 @@ import (
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/accesstokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/deploytokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/epics"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/groups"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/members"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/samlgrouplinks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/serviceaccounttokens"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/variables"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/groups/wikipages"
 )
 
 // Setup all group controllers
@@ -34,9 +37,12 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		groups.SetupGroup,
 		members.SetupMember,
 		accesstokens.SetupAccessToken,
+		serviceaccounttokens.SetupServiceAccountToken,
 		deploytokens.SetupDeployToken,
 		variables.SetupVariable,
 		samlgrouplinks.SetupSamlGroupLink,
+		epics.SetupEpic,
+		wikipages.SetupWikiPage,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
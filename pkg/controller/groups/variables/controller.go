@@ -24,6 +24,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
@@ -37,7 +38,11 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/groups/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/groups"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -62,9 +67,10 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewVariableClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: groups.NewVariableClient, newGroupGetterFn: groups.NewGroupGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -92,6 +98,7 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube              client.Client
 	newGitlabClientFn func(cfg clients.Config) groups.VariableClient
+	newGroupGetterFn  func(cfg clients.Config) groups.GroupGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -103,6 +110,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
+	if cr.Spec.ForProvider.GroupID == nil && cr.Spec.ForProvider.GroupPath != nil {
+		id, err := groups.ResolveGroupPath(ctx, c.newGroupGetterFn(*cfg), *cr.Spec.ForProvider.GroupPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.GroupID = &id
+	}
 	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
@@ -120,10 +134,21 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errGroupIDMissing)
 	}
 
+	p := observedParameters(cr)
 	variable, res, err := e.client.GetVariable(
 		*cr.Spec.ForProvider.GroupID,
-		cr.Spec.ForProvider.Key,
+		p.Key,
+		groups.WithVariableFilter(p),
 		gitlab.WithContext(ctx))
+	if err != nil && clients.IsResponseNotFound(res) && !isSameScope(p, &cr.Spec.ForProvider) {
+		// The environment scope was changed in the spec and the variable
+		// may already have been moved to the new scope.
+		variable, res, err = e.client.GetVariable(
+			*cr.Spec.ForProvider.GroupID,
+			cr.Spec.ForProvider.Key,
+			groups.WithVariableFilter(&cr.Spec.ForProvider),
+			gitlab.WithContext(ctx))
+	}
 
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
@@ -140,13 +165,22 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	current := cr.Spec.ForProvider.DeepCopy()
 	groups.LateInitializeVariable(&cr.Spec.ForProvider, variable)
+	lateInitialized := !cmp.Equal(current, &cr.Spec.ForProvider)
+
+	// Variables created before they were named after their key and scope
+	// get the composite name on their first observation.
+	if name := externalname.FormatVariable(variable.Key, &variable.EnvironmentScope); meta.GetExternalName(cr) != name {
+		meta.SetExternalName(cr, name)
+		lateInitialized = true
+	}
 
+	cr.Status.AtProvider = groups.GenerateVariableObservation(variable)
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
 		ResourceUpToDate:        groups.IsVariableUpToDate(&cr.Spec.ForProvider, variable),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceLateInitialized: lateInitialized,
 	}, nil
 }
 
@@ -174,6 +208,8 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
+
+	meta.SetExternalName(cr, externalname.FormatVariable(cr.Spec.ForProvider.Key, cr.Spec.ForProvider.EnvironmentScope))
 	return managed.ExternalCreation{}, nil
 }
 
@@ -196,6 +232,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		*cr.Spec.ForProvider.GroupID,
 		cr.Spec.ForProvider.Key,
 		groups.GenerateUpdateVariableOptions(&cr.Spec.ForProvider),
+		groups.WithVariableFilter(observedParameters(cr)),
 		gitlab.WithContext(ctx),
 	)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
@@ -215,6 +252,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	_, err := e.client.RemoveVariable(
 		*cr.Spec.ForProvider.GroupID,
 		cr.Spec.ForProvider.Key,
+		groups.WithVariableFilter(observedParameters(cr)),
 		gitlab.WithContext(ctx),
 	)
 	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
@@ -225,6 +263,21 @@ func (e *external) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// observedParameters returns the parameters with the environment scope
+// recorded in the external name, under which GitLab knows the variable while
+// a change of the scope in the spec has not been applied yet.
+func observedParameters(cr *v1alpha1.Variable) *v1alpha1.VariableParameters {
+	p := cr.Spec.ForProvider.DeepCopy()
+	if key, scope, err := externalname.ParseVariable(meta.GetExternalName(cr)); err == nil && key == p.Key {
+		p.EnvironmentScope = &scope
+	}
+	return p
+}
+
+func isSameScope(a, b *v1alpha1.VariableParameters) bool {
+	return externalname.FormatVariable(a.Key, a.EnvironmentScope) == externalname.FormatVariable(b.Key, b.EnvironmentScope)
+}
+
 func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.SecretKeySelector, params *v1alpha1.VariableParameters) error {
 	// Fetch the Kubernetes secret.
 	secret := &corev1.Secret{}
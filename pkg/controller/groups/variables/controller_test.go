@@ -23,6 +23,7 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
@@ -43,6 +44,8 @@ var (
 	variableType     = v1alpha1.VariableTypeEnvVar
 	variableEnvScope = "*"
 	f                = false
+
+	variableValueChecksum = "03ac674216f3e15c761ee1a5e255f067953623c8b388b4459e13f978d7c846f4"
 )
 
 var (
@@ -69,6 +72,10 @@ func withConditions(c ...xpv1.Condition) variableModifier {
 	return func(r *v1alpha1.Variable) { r.Status.ConditionedStatus.Conditions = c }
 }
 
+func withStatus(s v1alpha1.VariableObservation) variableModifier {
+	return func(r *v1alpha1.Variable) { r.Status.AtProvider = s }
+}
+
 func withDefaultValues() variableModifier {
 	return func(pv *v1alpha1.Variable) {
 		pv.Spec.ForProvider = v1alpha1.VariableParameters{
@@ -132,6 +139,10 @@ func withEnvironmentScope(scope string) variableModifier {
 	}
 }
 
+func withExternalName(name string) variableModifier {
+	return func(r *v1alpha1.Variable) { meta.SetExternalName(r, name) }
+}
+
 func variable(m ...variableModifier) *v1alpha1.Variable {
 	cr := &v1alpha1.Variable{}
 	for _, f := range m {
@@ -158,12 +169,18 @@ func TestObserve(t *testing.T) {
 						return &pv, &gitlab.Response{}, nil
 					},
 				},
-				cr: variable(withDefaultValues()),
+				cr: variable(withDefaultValues(), withExternalName(variableKey)),
 			},
 			want: want{
 				cr: variable(
 					withDefaultValues(),
+					withExternalName(variableKey),
 					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.VariableObservation{
+						VariableType:     variableType,
+						EnvironmentScope: variableEnvScope,
+						ValueChecksum:    variableValueChecksum,
+					}),
 				),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -182,14 +199,21 @@ func TestObserve(t *testing.T) {
 				},
 				cr: variable(
 					withDefaultValues(),
+					withExternalName(variableKey),
 					withValue("blah"),
 				),
 			},
 			want: want{
 				cr: variable(
 					withDefaultValues(),
+					withExternalName(variableKey),
 					withValue("blah"),
 					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.VariableObservation{
+						VariableType:     variableType,
+						EnvironmentScope: variableEnvScope,
+						ValueChecksum:    "7f21f0aa1e8cb45cd63d7b1b4382ea5429dd95d6536a9999256eaabee67467bf",
+					}),
 				),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -218,6 +242,7 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: variable(
 					withDefaultValues(),
+					withExternalName(variableKey),
 					withKey(variableKey),
 					// We expect the masked value to be late-inited to true
 					withMasked(true),
@@ -225,6 +250,12 @@ func TestObserve(t *testing.T) {
 					// as it was already set in the existing CR.
 					withVariableType(v1alpha1.VariableTypeEnvVar),
 					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.VariableObservation{
+						VariableType:     v1alpha1.VariableTypeFile,
+						Masked:           true,
+						EnvironmentScope: variableEnvScope,
+						ValueChecksum:    variableValueChecksum,
+					}),
 				),
 				result: managed.ExternalObservation{
 					ResourceExists: true,
@@ -235,6 +266,36 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ConvertLegacyExternalName": {
+			args: args{
+				variable: &fake.MockClient{
+					MockGetGroupVariable: func(pid interface{}, key string, options ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+						return &pv, &gitlab.Response{}, nil
+					},
+				},
+				cr: variable(
+					withDefaultValues(),
+					withExternalName("my-variable"),
+				),
+			},
+			want: want{
+				cr: variable(
+					withDefaultValues(),
+					withExternalName(variableKey),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.VariableObservation{
+						VariableType:     variableType,
+						EnvironmentScope: variableEnvScope,
+						ValueChecksum:    variableValueChecksum,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
 		"GetError": {
 			args: args{
 				variable: &fake.MockClient{
@@ -420,6 +481,7 @@ func TestCreate(t *testing.T) {
 				cr: variable(
 					withDefaultValues(),
 					withConditions(xpv1.Creating()),
+					withExternalName(variableKey),
 				),
 				result: managed.ExternalCreation{},
 			},
@@ -474,6 +536,7 @@ func TestCreate(t *testing.T) {
 					withGroupID(groupID),
 					withKey(variableKey),
 					withConditions(xpv1.Creating()),
+					withExternalName(variableKey),
 					withValueSecretRef(&xpv1.SecretKeySelector{
 						SecretReference: xpv1.SecretReference{},
 						Key:             "blah",
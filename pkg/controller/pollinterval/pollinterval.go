@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pollinterval lets managed resources override the provider-wide poll
+// interval, so that rarely changing resources can be observed less often than
+// frequently changing ones.
+package pollinterval
+
+import (
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyPollInterval overrides the poll interval of the managed
+// resource it is set on. Its value is a duration such as 30s or 1h.
+const AnnotationKeyPollInterval = "gitlab.crossplane.io/poll-interval"
+
+// MinPollInterval is the shortest poll interval a resource may request, which
+// protects GitLab from resources that would otherwise be observed in a loop.
+const MinPollInterval = 10 * time.Second
+
+// Hook is a managed.PollIntervalHook that returns the poll interval set by
+// the AnnotationKeyPollInterval annotation of the managed resource, and the
+// provider-wide pollInterval if the annotation is absent or not a positive
+// duration. Failed reconciliations are not affected, they keep being requeued
+// with exponential backoff.
+func Hook(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	v, ok := mg.GetAnnotations()[AnnotationKeyPollInterval]
+	if !ok {
+		return pollInterval
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return pollInterval
+	}
+	if d < MinPollInterval {
+		return MinPollInterval
+	}
+	return d
+}
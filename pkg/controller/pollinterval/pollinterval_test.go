@@ -0,0 +1,61 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pollinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHook(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		want        time.Duration
+	}{
+		"NoAnnotation": {
+			want: time.Minute,
+		},
+		"Override": {
+			annotations: map[string]string{AnnotationKeyPollInterval: "1h"},
+			want:        time.Hour,
+		},
+		"Invalid": {
+			annotations: map[string]string{AnnotationKeyPollInterval: "hourly"},
+			want:        time.Minute,
+		},
+		"Negative": {
+			annotations: map[string]string{AnnotationKeyPollInterval: "-5m"},
+			want:        time.Minute,
+		},
+		"BelowMinimum": {
+			annotations: map[string]string{AnnotationKeyPollInterval: "1s"},
+			want:        MinPollInterval,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+			if diff := cmp.Diff(tc.want, Hook(mg, time.Minute)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
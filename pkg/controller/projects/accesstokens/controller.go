@@ -18,6 +18,8 @@ package accesstokens
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -33,13 +35,18 @@ import (
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -49,9 +56,16 @@ const (
 	errFailedParseID        = "cannot parse Access Token ID to int"
 	errGetFailed            = "cannot get Gitlab accesstoken"
 	errCreateFailed         = "cannot create Gitlab accesstoken"
+	errRecreateFailed       = "cannot recreate Gitlab accesstoken"
+	errRotateFailed         = "cannot rotate Gitlab accesstoken"
+	errKubeUpdateFailed     = "cannot update Gitlab accesstoken custom resource"
 	errDeleteFailed         = "cannot delete Gitlab accesstoken"
 	errAccessTokentNotFound = "cannot find Gitlab accesstoken"
 	errMissingProjectID     = "missing Spec.ForProvider.ProjectID"
+	errExpiresAtInPast      = "Spec.ForProvider.ExpiresAt must be in the future"
+
+	reasonRecreated event.Reason = "RecreatedAccessToken"
+	reasonRotated   event.Reason = "RotatedAccessToken"
 )
 
 // SetupAccessToken adds a controller that reconciles ProjectAccessTokens.
@@ -63,12 +77,15 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewAccessTokenClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), recorder: recorder, newGitlabClientFn: projects.NewAccessTokenClient, newProjectGetterFn: projects.NewProjectGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -92,8 +109,10 @@ func SetupAccessToken(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg clients.Config) projects.AccessTokenClient
+	kube               client.Client
+	recorder           event.Recorder
+	newGitlabClientFn  func(cfg clients.Config) projects.AccessTokenClient
+	newProjectGetterFn func(cfg clients.Config) projects.ProjectGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -105,12 +124,20 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		id, err := projects.ResolveProjectPath(ctx, c.newProjectGetterFn(*cfg), *cr.Spec.ForProvider.ProjectPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.ProjectID = clients.StringToPtr(strconv.Itoa(id))
+	}
+	return &external{kube: c.kube, recorder: c.recorder, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.AccessTokenClient
+	kube     client.Client
+	recorder event.Recorder
+	client   projects.AccessTokenClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -124,7 +151,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, nil
 	}
 
-	accessTokenID, err := strconv.Atoi(externalName)
+	accessTokenID, err := externalname.ParseID(externalName)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errFailedParseID)
 	}
@@ -144,11 +171,22 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitializeProjectAccessToken(&cr.Spec.ForProvider, at)
 
-	cr.Status.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = projects.GenerateProjectAccessTokenObservation(at)
+	cr.Status.SetConditions(xpv1.Available(), clients.TokenExpiring(at.ExpiresAt, cr.Spec.ForProvider.ExpiryWarningDays, time.Now()))
+
+	// Scopes and access level cannot be changed on an existing token, so a
+	// drift is only reported when the token is allowed to be recreated.
+	upToDate := true
+	if ptr.Deref(cr.Spec.ForProvider.AllowRecreate, false) {
+		upToDate = isAccessTokenUpToDate(&cr.Spec.ForProvider, at)
+	}
+	if projects.IsProjectAccessTokenExpiring(&cr.Spec.ForProvider, at, time.Now()) {
+		upToDate = false
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        true,
+		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -163,6 +201,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errMissingProjectID)
 	}
 
+	// CEL has no notion of the current time, so this can't be validated by
+	// the API server.
+	if at := cr.Spec.ForProvider.ExpiresAt; at != nil && !at.After(time.Now()) {
+		return managed.ExternalCreation{}, errors.New(errExpiresAtInPast)
+	}
+
 	at, _, err := e.client.CreateProjectAccessToken(
 		*cr.Spec.ForProvider.ProjectID,
 		projects.GenerateCreateProjectAccessTokenOptions(cr.Name, &cr.Spec.ForProvider),
@@ -173,7 +217,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
 
-	meta.SetExternalName(cr, strconv.Itoa(at.ID))
+	meta.SetExternalName(cr, externalname.FormatID(at.ID))
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{
 			"token": []byte(at.Token),
@@ -182,17 +226,104 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	// it's not possible to update a ProjectAccessToken
+	cr, ok := mg.(*v1alpha1.AccessToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccessToken)
+	}
+
+	accessTokenID, err := externalname.ParseID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errExternalNameNotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errMissingProjectID)
+	}
+
+	at, _, err := e.client.GetProjectAccessToken(*cr.Spec.ForProvider.ProjectID, accessTokenID)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
+	}
+
+	// it's not possible to update a ProjectAccessToken, it can only be
+	// recreated or rotated
+	switch {
+	case ptr.Deref(cr.Spec.ForProvider.AllowRecreate, false) && !isAccessTokenUpToDate(&cr.Spec.ForProvider, at):
+		return e.recreate(ctx, cr, accessTokenID)
+	case projects.IsProjectAccessTokenExpiring(&cr.Spec.ForProvider, at, time.Now()):
+		return e.rotate(ctx, cr, accessTokenID)
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
+// recreate replaces the access token with a new one matching the spec. The
+// replacement is created before the old token is revoked so that a valid
+// token is published at all times.
+func (e *external) recreate(ctx context.Context, cr *v1alpha1.AccessToken, oldID int) (managed.ExternalUpdate, error) {
+	at, _, err := e.client.CreateProjectAccessToken(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateProjectAccessTokenOptions(cr.Name, &cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRecreateFailed)
+	}
+
+	meta.SetExternalName(cr, externalname.FormatID(at.ID))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+
+	if _, err := e.client.RevokeProjectAccessToken(*cr.Spec.ForProvider.ProjectID, oldID, gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteFailed)
+	}
+
+	e.recorder.Event(cr, event.Normal(reasonRecreated, fmt.Sprintf(
+		"Access token %d was revoked and replaced by %d because its scopes or access level changed", oldID, at.ID)))
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			"token": []byte(at.Token),
+		},
+	}, nil
+}
+
+// rotate rotates the access token according to its expiresAt policy. GitLab
+// revokes the old token and returns a new one with a new ID.
+func (e *external) rotate(ctx context.Context, cr *v1alpha1.AccessToken, oldID int) (managed.ExternalUpdate, error) {
+	at, _, err := e.client.RotateProjectAccessToken(
+		*cr.Spec.ForProvider.ProjectID,
+		oldID,
+		projects.GenerateRotateProjectAccessTokenOptions(&cr.Spec.ForProvider, time.Now()),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotateFailed)
+	}
+
+	meta.SetExternalName(cr, externalname.FormatID(at.ID))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+
+	e.recorder.Event(cr, event.Normal(reasonRotated, fmt.Sprintf(
+		"Access token %d was rotated to %d because it was about to expire", oldID, at.ID)))
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: managed.ConnectionDetails{
+			"token": []byte(at.Token),
+		},
+	}, nil
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*v1alpha1.AccessToken)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotAccessToken)
 	}
 
-	accessTokenID, err := strconv.Atoi(meta.GetExternalName(cr))
+	accessTokenID, err := externalname.ParseID(meta.GetExternalName(cr))
 
 	if err != nil {
 		return managed.ExternalDelete{}, errors.New(errExternalNameNotInt)
@@ -230,3 +361,18 @@ func lateInitializeProjectAccessToken(in *v1alpha1.AccessTokenParameters, access
 		in.ExpiresAt = &metav1.Time{Time: time.Time(*accessToken.ExpiresAt)}
 	}
 }
+
+// isAccessTokenUpToDate checks whether the immutable attributes of the access
+// token still match the spec.
+func isAccessTokenUpToDate(p *v1alpha1.AccessTokenParameters, at *gitlab.ProjectAccessToken) bool {
+	if p.AccessLevel != nil && int(*p.AccessLevel) != int(at.AccessLevel) {
+		return false
+	}
+
+	desired := append([]string{}, p.Scopes...)
+	observed := append([]string{}, at.Scopes...)
+	sort.Strings(desired)
+	sort.Strings(observed)
+
+	return cmp.Equal(desired, observed)
+}
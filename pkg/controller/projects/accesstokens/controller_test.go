@@ -25,6 +25,7 @@ import (
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -32,10 +33,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
 )
@@ -51,6 +54,12 @@ var (
 	accessLevel    = 40
 	name           = "Access Token Name"
 	token          = "Token"
+	allowRecreate  = true
+	expiresSoon    = time.Now().AddDate(0, 0, 3)
+	rotatePolicy   = v1alpha1.ExpiresAtPolicy{
+		RotateDaysBefore: 7,
+		ValidityDays:     90,
+	}
 	accessTokenObj = gitlab.ProjectAccessToken{
 		ID:          accessTokenID,
 		Name:        name,
@@ -79,6 +88,10 @@ func withSpec(fp v1alpha1.AccessTokenParameters) accessTokenModifier {
 	return func(r *v1alpha1.AccessToken) { r.Spec.ForProvider = fp }
 }
 
+func withStatus(o v1alpha1.AccessTokenObservation) accessTokenModifier {
+	return func(r *v1alpha1.AccessToken) { r.Status.AtProvider = o }
+}
+
 func withExternalName(accessTokenID string) accessTokenModifier {
 	return func(r *v1alpha1.AccessToken) { meta.SetExternalName(r, accessTokenID) }
 }
@@ -102,6 +115,18 @@ func TestObserve(t *testing.T) {
 		err    error
 	}
 
+	notExpiring := xpv1.Condition{
+		Type:   clients.TypeTokenExpiring,
+		Status: corev1.ConditionFalse,
+		Reason: clients.ReasonTokenNotExpiring,
+	}
+	expiring := xpv1.Condition{
+		Type:    clients.TypeTokenExpiring,
+		Status:  corev1.ConditionTrue,
+		Reason:  clients.ReasonTokenExpiring,
+		Message: "Access token expires at " + expiresSoon.Format(time.RFC3339),
+	}
+
 	cases := map[string]struct {
 		args
 		want
@@ -237,7 +262,8 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
-					withConditions(xpv1.Available()),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: gitlab.Ptr(0)}),
 					withSpec(v1alpha1.AccessTokenParameters{
 						ProjectID:   &projectID,
 						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
@@ -271,7 +297,8 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
-					withConditions(xpv1.Available()),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: gitlab.Ptr(0), ExpiresAt: &v1.Time{Time: expiresAt}}),
 					withSpec(v1alpha1.AccessTokenParameters{
 						ProjectID:   &projectID,
 						ExpiresAt:   &v1.Time{Time: expiresAt},
@@ -304,11 +331,48 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accessToken(
 					withExternalName(sAccessTokenID),
-					withConditions(xpv1.Available()),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: gitlab.Ptr(0)}),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:   &projectID,
+						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:   &v1.Time{Time: expiresAt},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"ScopesChangedWithoutAllowRecreate": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:   &projectID,
+						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:   &v1.Time{Time: expiresAt},
+						Scopes:      []string{"scope1"},
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: &accessTokenID, ExpiresAt: &v1.Time{Time: expiresAt}}),
 					withSpec(v1alpha1.AccessTokenParameters{
 						ProjectID:   &projectID,
 						AccessLevel: (*v1alpha1.AccessLevelValue)(&accessLevel),
 						ExpiresAt:   &v1.Time{Time: expiresAt},
+						Scopes:      []string{"scope1"},
 					}),
 				),
 				result: managed.ExternalObservation{
@@ -318,6 +382,118 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ScopesChangedWithAllowRecreate": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AccessLevel:   (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:     &v1.Time{Time: expiresAt},
+						Scopes:        []string{"scope1"},
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: &accessTokenID, ExpiresAt: &v1.Time{Time: expiresAt}}),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AccessLevel:   (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:     &v1.Time{Time: expiresAt},
+						Scopes:        []string{"scope1"},
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"ScopesReorderedWithAllowRecreate": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AccessLevel:   (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:     &v1.Time{Time: expiresAt},
+						Scopes:        []string{"scope2", "scope1"},
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withConditions(xpv1.Available(), notExpiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: &accessTokenID, ExpiresAt: &v1.Time{Time: expiresAt}}),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AccessLevel:   (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:     &v1.Time{Time: expiresAt},
+						Scopes:        []string{"scope2", "scope1"},
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
+		"AccessTokenExpiring": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{ExpiresAt: (*gitlab.ISOTime)(&expiresSoon)}, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:       &projectID,
+						AccessLevel:     (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:       &v1.Time{Time: expiresSoon},
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withConditions(xpv1.Available(), expiring),
+					withStatus(v1alpha1.AccessTokenObservation{TokenID: gitlab.Ptr(0), ExpiresAt: &v1.Time{Time: expiresSoon}}),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:       &projectID,
+						AccessLevel:     (*v1alpha1.AccessLevelValue)(&accessLevel),
+						ExpiresAt:       &v1.Time{Time: expiresSoon},
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -369,6 +545,26 @@ func TestCreate(t *testing.T) {
 				err:    errors.New(errMissingProjectID),
 			},
 		},
+		"ExpiresAtInPast": {
+			args: args{
+				cr: accessToken(
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID: &projectID,
+						ExpiresAt: &v1.Time{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID: &projectID,
+						ExpiresAt: &v1.Time{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+					}),
+				),
+				result: managed.ExternalCreation{},
+				err:    errors.New(errExpiresAtInPast),
+			},
+		},
 		"CreationFailedErr": {
 			args: args{
 				accessTokenClient: &fake.MockClient{
@@ -477,19 +673,241 @@ func TestUpdate(t *testing.T) {
 		args
 		want
 	}{
-		"SuccessfulUpdate": {
+		"InvalidInput": {
 			args: args{
-				cr: accessToken(),
+				cr: invalidInput,
 			},
 			want: want{
-				cr:     accessToken(),
-				result: managed.ExternalUpdate{},
+				cr:  invalidInput,
+				err: errors.New(errNotAccessToken),
+			},
+		},
+		"ExternalNameNotInt": {
+			args: args{
+				cr: accessToken(withExternalName(wrongIDstr)),
+			},
+			want: want{
+				cr:  accessToken(withExternalName(wrongIDstr)),
+				err: errors.New(errExternalNameNotInt),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"NothingToUpdate": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{Scopes: []string{"scope1"}}, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID: &projectID,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID: &projectID,
+					}),
+				),
+			},
+		},
+		"RecreateFailed": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{Scopes: []string{"scope1"}}, &gitlab.Response{}, nil
+					},
+					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+				err: errors.Wrap(errBoom, errRecreateFailed),
+			},
+		},
+		"RevokeFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{Scopes: []string{"scope1"}}, &gitlab.Response{}, nil
+					},
+					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+					MockRevokeProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+		"SuccessfulRecreate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{Scopes: []string{"scope1"}}, &gitlab.Response{}, nil
+					},
+					MockCreateProjectAccessToken: func(pid interface{}, opt *gitlab.CreateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+					MockRevokeProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:     &projectID,
+						AllowRecreate: &allowRecreate,
+					}),
+				),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+				},
+			},
+		},
+		"RotateFailed": {
+			args: args{
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{ExpiresAt: (*gitlab.ISOTime)(&expiresSoon)}, &gitlab.Response{}, nil
+					},
+					MockRotateProjectAccessToken: func(pid interface{}, id int, opt *gitlab.RotateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:       &projectID,
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:       &projectID,
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+				err: errors.Wrap(errBoom, errRotateFailed),
+			},
+		},
+		"SuccessfulRotate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				accessTokenClient: &fake.MockClient{
+					MockGetProjectAccessToken: func(pid interface{}, id int, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &gitlab.ProjectAccessToken{ExpiresAt: (*gitlab.ISOTime)(&expiresSoon)}, &gitlab.Response{}, nil
+					},
+					MockRotateProjectAccessToken: func(pid interface{}, id int, opt *gitlab.RotateProjectAccessTokenOptions, options ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+						return &accessTokenObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: accessToken(
+					withExternalName("1"),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:       &projectID,
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+			},
+			want: want{
+				cr: accessToken(
+					withExternalName(sAccessTokenID),
+					withSpec(v1alpha1.AccessTokenParameters{
+						ProjectID:       &projectID,
+						ExpiresAtPolicy: &rotatePolicy,
+					}),
+				),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{"token": []byte(token)},
+				},
 			},
 		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.accessTokenClient}
+			e := &external{kube: tc.kube, recorder: event.NewNopRecorder(), client: tc.accessTokenClient}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
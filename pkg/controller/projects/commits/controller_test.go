@@ -0,0 +1,291 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commits
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	projectID     = "1234"
+	sha           = "ed899a2f4b50b4370feeea94676502b42383c746"
+	branch        = "main"
+	message       = "Add scaffold"
+	content       = "# example\n"
+	commitObj     = &gitlab.Commit{
+		ID:      sha,
+		ShortID: "ed899a2f",
+		Title:   message,
+		WebURL:  "https://gitlab.example.com/group/project/-/commit/" + sha,
+	}
+)
+
+type args struct {
+	commit projects.CommitClient
+	kube   client.Client
+	cr     resource.Managed
+}
+
+type commitModifier func(*v1alpha1.Commit)
+
+func withConditions(c ...xpv1.Condition) commitModifier {
+	return func(r *v1alpha1.Commit) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.CommitParameters) commitModifier {
+	return func(r *v1alpha1.Commit) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.CommitObservation) commitModifier {
+	return func(r *v1alpha1.Commit) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) commitModifier {
+	return func(r *v1alpha1.Commit) { meta.SetExternalName(r, n) }
+}
+
+func commit(m ...commitModifier) *v1alpha1.Commit {
+	cr := &v1alpha1.Commit{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.CommitParameters {
+	return v1alpha1.CommitParameters{
+		ProjectID:     &projectID,
+		Branch:        branch,
+		CommitMessage: message,
+		Actions: []v1alpha1.CommitAction{
+			{Action: v1alpha1.CommitActionCreate, FilePath: "README.md", Content: &content},
+			{Action: v1alpha1.CommitActionMove, FilePath: "docs/index.md", PreviousPath: gitlab.Ptr("index.md")},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotCommit),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: commit(),
+			},
+			want: want{
+				cr: commit(),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: commit(withExternalName(sha)),
+			},
+			want: want{
+				cr:  commit(withExternalName(sha)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				commit: &fake.MockClient{
+					MockGetCommit: func(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: commit(withExternalName(sha), withSpec(params())),
+			},
+			want: want{
+				cr: commit(withExternalName(sha), withSpec(params())),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				commit: &fake.MockClient{
+					MockGetCommit: func(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: commit(withExternalName(sha), withSpec(params())),
+			},
+			want: want{
+				cr:  commit(withExternalName(sha), withSpec(params())),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"Success": {
+			args: args{
+				commit: &fake.MockClient{
+					MockGetCommit: func(pid interface{}, sha string, opt *gitlab.GetCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+						return commitObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: commit(withExternalName(sha), withSpec(params())),
+			},
+			want: want{
+				cr: commit(
+					withExternalName(sha),
+					withSpec(params()),
+					withStatus(v1alpha1.CommitObservation{
+						ID:      sha,
+						ShortID: commitObj.ShortID,
+						Title:   message,
+						WebURL:  commitObj.WebURL,
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.commit}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotCommit),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: commit(),
+			},
+			want: want{
+				cr:  commit(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				commit: &fake.MockClient{
+					MockCreateCommit: func(pid interface{}, opt *gitlab.CreateCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+						if diff := cmp.Diff(projects.GenerateCreateCommitOptions(&v1alpha1.CommitParameters{
+							Branch:        branch,
+							CommitMessage: message,
+							Actions:       params().Actions,
+						}), opt); diff != "" {
+							t.Errorf("CreateCommit options: -want, +got:\n%s", diff)
+						}
+						return commitObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: commit(withSpec(params())),
+			},
+			want: want{
+				cr: commit(withSpec(params()), withExternalName(sha)),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				commit: &fake.MockClient{
+					MockCreateCommit: func(pid interface{}, opt *gitlab.CreateCommitOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Commit, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: commit(withSpec(params())),
+			},
+			want: want{
+				cr:  commit(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.commit}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package deploykeys
 
 import (
 	"context"
-	"strconv"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -24,7 +23,11 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -60,9 +63,10 @@ func SetupDeployKey(mgr ctrl.Manager, o crpc.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newDeployKeyClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: newDeployKeyClient}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -114,7 +118,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
 	}
 
-	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	id, err := externalname.ParseID(meta.GetExternalName(cr))
 
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errIDNotAnInt)
@@ -187,7 +191,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFail)
 	}
 
-	id := strconv.Itoa(keyResponse.ID)
+	id := externalname.FormatID(keyResponse.ID)
 	meta.SetExternalName(cr, id)
 
 	return managed.ExternalCreation{}, nil
@@ -205,7 +209,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	idString := meta.GetExternalName(cr)
-	id, err := strconv.Atoi(idString)
+	id, err := externalname.ParseID(idString)
 
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errIDNotAnInt)
@@ -232,7 +236,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	keyIDString := meta.GetExternalName(cr)
-	keyID, err := strconv.Atoi(keyIDString)
+	keyID, err := externalname.ParseID(keyIDString)
 
 	if err != nil {
 		return managed.ExternalDelete{}, errors.Wrap(err, errIDNotAnInt)
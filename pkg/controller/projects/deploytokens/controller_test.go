@@ -321,7 +321,7 @@ func TestCreate(t *testing.T) {
 					}),
 				),
 				result: managed.ExternalCreation{
-					ConnectionDetails: managed.ConnectionDetails{"token": []byte("Token")},
+					ConnectionDetails: managed.ConnectionDetails{"username": []byte(username), "token": []byte("Token")},
 				},
 			},
 		},
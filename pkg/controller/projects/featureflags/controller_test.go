@@ -0,0 +1,478 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featureflags
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	projectID     = "1234"
+	flagName      = "new-checkout"
+	description   = "Rolls out the new checkout"
+	active        = true
+	strategies    = []v1alpha1.FeatureFlagStrategy{
+		{
+			Name:       v1alpha1.FeatureFlagStrategyFlexibleRollout,
+			Parameters: &v1alpha1.FeatureFlagStrategyParameters{Rollout: gitlab.Ptr("50"), GroupID: gitlab.Ptr("default"), Stickiness: gitlab.Ptr("default")},
+			Scopes:     []v1alpha1.FeatureFlagScope{{EnvironmentScope: "production"}},
+		},
+	}
+	flagObj = &projects.FeatureFlag{
+		Name:        flagName,
+		Description: description,
+		Active:      true,
+		Version:     "new_version_flag",
+		Strategies: []*projects.FeatureFlagStrategy{
+			{
+				ID:         7,
+				Name:       "flexibleRollout",
+				Parameters: &gitlab.ProjectFeatureFlagStrategyParameter{Rollout: "50", GroupID: "default", Stickiness: "default"},
+				Scopes:     []*gitlab.ProjectFeatureFlagScope{{ID: 8, EnvironmentScope: "production"}},
+			},
+		},
+	}
+	flagNotFound = func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+		return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+	}
+)
+
+type args struct {
+	flag projects.FeatureFlagClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type featureFlagModifier func(*v1alpha1.FeatureFlag)
+
+func withConditions(c ...xpv1.Condition) featureFlagModifier {
+	return func(r *v1alpha1.FeatureFlag) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.FeatureFlagParameters) featureFlagModifier {
+	return func(r *v1alpha1.FeatureFlag) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.FeatureFlagObservation) featureFlagModifier {
+	return func(r *v1alpha1.FeatureFlag) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) featureFlagModifier {
+	return func(r *v1alpha1.FeatureFlag) { meta.SetExternalName(r, n) }
+}
+
+func featureFlag(m ...featureFlagModifier) *v1alpha1.FeatureFlag {
+	cr := &v1alpha1.FeatureFlag{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotFeatureFlag),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: featureFlag(),
+			},
+			want: want{
+				cr: featureFlag(),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: featureFlag(withExternalName(flagName)),
+			},
+			want: want{
+				cr:  featureFlag(withExternalName(flagName)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				flag: &fake.MockClient{
+					MockGetFeatureFlag: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr:  featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"FlagNotFound": {
+			args: args{
+				flag: &fake.MockClient{
+					MockGetFeatureFlag: flagNotFound,
+				},
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				flag: &fake.MockClient{
+					MockGetFeatureFlag: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						return flagObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: featureFlag(
+					withExternalName(flagName),
+					withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID, Name: flagName, Strategies: strategies}),
+				),
+			},
+			want: want{
+				cr: featureFlag(
+					withExternalName(flagName),
+					withSpec(v1alpha1.FeatureFlagParameters{
+						ProjectID:   &projectID,
+						Name:        flagName,
+						Description: &description,
+						Active:      &active,
+						Strategies:  strategies,
+					}),
+					withStatus(v1alpha1.FeatureFlagObservation{Version: "new_version_flag"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				flag: &fake.MockClient{
+					MockGetFeatureFlag: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						return flagObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: featureFlag(
+					withExternalName(flagName),
+					withSpec(v1alpha1.FeatureFlagParameters{
+						ProjectID:   &projectID,
+						Name:        flagName,
+						Description: &description,
+						Active:      &active,
+					}),
+				),
+			},
+			want: want{
+				cr: featureFlag(
+					withExternalName(flagName),
+					withSpec(v1alpha1.FeatureFlagParameters{
+						ProjectID:   &projectID,
+						Name:        flagName,
+						Description: &description,
+						Active:      &active,
+					}),
+					withStatus(v1alpha1.FeatureFlagObservation{Version: "new_version_flag"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.flag}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotFeatureFlag),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: featureFlag(),
+			},
+			want: want{
+				cr:  featureFlag(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				flag: &fake.MockClient{
+					MockCreateFeatureFlag: func(pid interface{}, opt *projects.FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						return flagObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: featureFlag(withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID, Name: flagName})),
+			},
+			want: want{
+				cr: featureFlag(
+					withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID, Name: flagName}),
+					withExternalName(flagName),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				flag: &fake.MockClient{
+					MockCreateFeatureFlag: func(pid interface{}, opt *projects.FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: featureFlag(withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID, Name: flagName})),
+			},
+			want: want{
+				cr:  featureFlag(withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID, Name: flagName})),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.flag}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotFeatureFlag),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				flag: &fake.MockClient{
+					MockGetFeatureFlag: flagNotFound,
+				},
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr:  featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"SuccessfulUpdateRemovesStrategies": {
+			args: args{
+				flag: &fake.MockClient{
+					MockGetFeatureFlag: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						return flagObj, &gitlab.Response{}, nil
+					},
+					MockUpdateFeatureFlag: func(pid interface{}, name string, opt *projects.FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						want := []*projects.FeatureFlagStrategyOptions{{ID: gitlab.Ptr(7), Destroy: gitlab.Ptr(true)}}
+						if diff := cmp.Diff(want, opt.Strategies); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return flagObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				flag: &fake.MockClient{
+					MockGetFeatureFlag: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						return flagObj, &gitlab.Response{}, nil
+					},
+					MockUpdateFeatureFlag: func(pid interface{}, name string, opt *projects.FeatureFlagOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlag, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr:  featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.flag}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotFeatureFlag),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				flag: &fake.MockClient{
+					MockDeleteFeatureFlag: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				flag: &fake.MockClient{
+					MockDeleteFeatureFlag: func(pid interface{}, name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr:  featureFlag(withExternalName(flagName), withSpec(v1alpha1.FeatureFlagParameters{ProjectID: &projectID})),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.flag}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,436 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featureflaguserlists
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	projectID     = "1234"
+	listIID       = 3
+	sListIID      = strconv.Itoa(listIID)
+	listName      = "beta-testers"
+	listObj       = &projects.FeatureFlagUserList{
+		ID:        42,
+		IID:       listIID,
+		ProjectID: 1234,
+		Name:      listName,
+		UserXIDs:  "user1,user2",
+	}
+	params = v1alpha1.FeatureFlagUserListParameters{
+		ProjectID: &projectID,
+		Name:      listName,
+		UserXIDs:  []string{"user2", "user1"},
+	}
+)
+
+type args struct {
+	list projects.FeatureFlagUserListClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type userListModifier func(*v1alpha1.FeatureFlagUserList)
+
+func withConditions(c ...xpv1.Condition) userListModifier {
+	return func(r *v1alpha1.FeatureFlagUserList) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.FeatureFlagUserListParameters) userListModifier {
+	return func(r *v1alpha1.FeatureFlagUserList) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.FeatureFlagUserListObservation) userListModifier {
+	return func(r *v1alpha1.FeatureFlagUserList) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) userListModifier {
+	return func(r *v1alpha1.FeatureFlagUserList) { meta.SetExternalName(r, n) }
+}
+
+func userList(m ...userListModifier) *v1alpha1.FeatureFlagUserList {
+	cr := &v1alpha1.FeatureFlagUserList{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	changed := params
+	changed.UserXIDs = []string{"user1", "user3"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotFeatureFlagUserList),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: userList(),
+			},
+			want: want{
+				cr: userList(),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: userList(withExternalName("fr")),
+			},
+			want: want{
+				cr:  userList(withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: userList(withExternalName(sListIID)),
+			},
+			want: want{
+				cr:  userList(withExternalName(sListIID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				list: &fake.MockClient{
+					MockGetFeatureFlagUserList: func(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+			want: want{
+				cr:  userList(withExternalName(sListIID), withSpec(params)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"UserListNotFound": {
+			args: args{
+				list: &fake.MockClient{
+					MockGetFeatureFlagUserList: func(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+			want: want{
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				list: &fake.MockClient{
+					MockGetFeatureFlagUserList: func(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+						return listObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+			want: want{
+				cr: userList(
+					withExternalName(sListIID),
+					withSpec(params),
+					withStatus(v1alpha1.FeatureFlagUserListObservation{ID: 42, IID: listIID}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				list: &fake.MockClient{
+					MockGetFeatureFlagUserList: func(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+						return listObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: userList(withExternalName(sListIID), withSpec(changed)),
+			},
+			want: want{
+				cr: userList(
+					withExternalName(sListIID),
+					withSpec(changed),
+					withStatus(v1alpha1.FeatureFlagUserListObservation{ID: 42, IID: listIID}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.list}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotFeatureFlagUserList),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: userList(),
+			},
+			want: want{
+				cr:  userList(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				list: &fake.MockClient{
+					MockCreateFeatureFlagUserList: func(pid interface{}, opt *projects.FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+						if *opt.UserXIDs != "user2,user1" {
+							t.Errorf("unexpected user_xids %q", *opt.UserXIDs)
+						}
+						return listObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: userList(withSpec(params)),
+			},
+			want: want{
+				cr: userList(withSpec(params), withExternalName(sListIID)),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				list: &fake.MockClient{
+					MockCreateFeatureFlagUserList: func(pid interface{}, opt *projects.FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: userList(withSpec(params)),
+			},
+			want: want{
+				cr:  userList(withSpec(params)),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.list}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotFeatureFlagUserList),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				list: &fake.MockClient{
+					MockUpdateFeatureFlagUserList: func(pid interface{}, iid int, opt *projects.FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+						return listObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+			want: want{
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				list: &fake.MockClient{
+					MockUpdateFeatureFlagUserList: func(pid interface{}, iid int, opt *projects.FeatureFlagUserListOptions, options ...gitlab.RequestOptionFunc) (*projects.FeatureFlagUserList, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+			want: want{
+				cr:  userList(withExternalName(sListIID), withSpec(params)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.list}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotFeatureFlagUserList),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				list: &fake.MockClient{
+					MockDeleteFeatureFlagUserList: func(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+			want: want{
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				list: &fake.MockClient{
+					MockDeleteFeatureFlagUserList: func(pid interface{}, iid int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: userList(withExternalName(sListIID), withSpec(params)),
+			},
+			want: want{
+				cr:  userList(withExternalName(sListIID), withSpec(params)),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.list}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,7 +18,6 @@ package hooks
 
 import (
 	"context"
-	"strconv"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -37,7 +36,11 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -62,9 +65,10 @@ func SetupHook(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewHookClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewHookClient, newProjectGetterFn: projects.NewProjectGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -90,8 +94,9 @@ func SetupHook(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg clients.Config) projects.HookClient
+	kube               client.Client
+	newGitlabClientFn  func(cfg clients.Config) projects.HookClient
+	newProjectGetterFn func(cfg clients.Config) projects.ProjectGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -103,6 +108,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		id, err := projects.ResolveProjectPath(ctx, c.newProjectGetterFn(*cfg), *cr.Spec.ForProvider.ProjectPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.ProjectID = &id
+	}
 	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
@@ -123,7 +135,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}, nil
 	}
 
-	hookid, err := strconv.Atoi(meta.GetExternalName(cr))
+	hookid, err := externalname.ParseID(meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errNotHook)
 	}
@@ -180,7 +192,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotHook)
 	}
 
-	hookid, err := strconv.Atoi(meta.GetExternalName(cr))
+	hookid, err := externalname.ParseID(meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.New(errNotHook)
 	}
@@ -224,6 +236,6 @@ func (e *external) Disconnect(ctx context.Context) error {
 }
 
 func (e *external) updateExternalName(ctx context.Context, cr *v1alpha1.Hook, projecthook *gitlab.ProjectHook) error {
-	meta.SetExternalName(cr, strconv.Itoa(projecthook.ID))
+	meta.SetExternalName(cr, externalname.FormatID(projecthook.ID))
 	return e.kube.Update(ctx, cr)
 }
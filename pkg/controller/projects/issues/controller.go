@@ -0,0 +1,304 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issues
+
+import (
+	"context"
+	"sort"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+)
+
+const (
+	errNotIssue         = "managed resource is not a Gitlab issue custom resource"
+	errGetFailed        = "cannot get Gitlab issue"
+	errCreateFailed     = "cannot create Gitlab issue"
+	errUpdateFailed     = "cannot update Gitlab issue"
+	errDeleteFailed     = "cannot delete Gitlab issue"
+	errIDNotInt         = "external-name is not an int"
+	errProjectIDMissing = "ProjectID is missing"
+)
+
+// SetupIssue adds a controller that reconciles Issues.
+func SetupIssue(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.IssueKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewIssueClient}))),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.IssueGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.IssueList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Issue{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.IssueClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return nil, errors.New(errNotIssue)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.IssueClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIssue)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	iid, err := externalname.ParseID(externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
+	}
+
+	issue, res, err := e.client.GetIssue(*cr.Spec.ForProvider.ProjectID, iid)
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitializeIssue(&cr.Spec.ForProvider, issue)
+
+	cr.Status.AtProvider = projects.GenerateIssueObservation(issue)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isIssueUpToDate(&cr.Spec.ForProvider, issue),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIssue)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	issue, _, err := e.client.CreateIssue(
+		*cr.Spec.ForProvider.ProjectID,
+		projects.GenerateCreateIssueOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
+	meta.SetExternalName(cr, externalname.FormatID(issue.IID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotIssue)
+	}
+
+	iid, err := externalname.ParseID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
+	}
+
+	_, _, err = e.client.UpdateIssue(
+		*cr.Spec.ForProvider.ProjectID,
+		iid,
+		projects.GenerateUpdateIssueOptions(&cr.Spec.ForProvider),
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotIssue)
+	}
+
+	iid, err := externalname.ParseID(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errors.New(errIDNotInt)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalDelete{}, errors.New(errProjectIDMissing)
+	}
+
+	_, err = e.client.DeleteIssue(
+		*cr.Spec.ForProvider.ProjectID,
+		iid,
+		gitlab.WithContext(ctx),
+	)
+	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// lateInitializeIssue fills the empty fields in the issue spec with the
+// values seen in gitlab issue.
+func lateInitializeIssue(in *v1alpha1.IssueParameters, issue *gitlab.Issue) {
+	if issue == nil {
+		return
+	}
+
+	in.Description = clients.LateInitializeStringPtr(in.Description, issue.Description)
+
+	if in.Confidential == nil {
+		in.Confidential = &issue.Confidential
+	}
+}
+
+// isIssueUpToDate checks whether there is a change in any of the modifiable fields.
+func isIssueUpToDate(p *v1alpha1.IssueParameters, i *gitlab.Issue) bool {
+	if p.Title != i.Title {
+		return false
+	}
+	if !clients.IsStringEqualToStringPtr(p.Description, i.Description) {
+		return false
+	}
+	if !clients.IsBoolEqualToBoolPtr(p.Confidential, i.Confidential) {
+		return false
+	}
+	if p.MilestoneID != nil && (i.Milestone == nil || *p.MilestoneID != i.Milestone.ID) {
+		return false
+	}
+	if p.Labels != nil && !isStringsEqual(p.Labels, i.Labels) {
+		return false
+	}
+	if p.AssigneeIDs != nil && !isIntsEqual(p.AssigneeIDs, assigneeIDs(i.Assignees)) {
+		return false
+	}
+	return true
+}
+
+// assigneeIDs returns the user IDs of the given assignees.
+func assigneeIDs(assignees []*gitlab.IssueAssignee) []int {
+	ids := make([]int, 0, len(assignees))
+	for _, a := range assignees {
+		if a != nil {
+			ids = append(ids, a.ID)
+		}
+	}
+	return ids
+}
+
+// isStringsEqual compares string slices regardless of their order.
+func isStringsEqual(want, got []string) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	w := append([]string{}, want...)
+	g := append([]string{}, got...)
+	sort.Strings(w)
+	sort.Strings(g)
+	return cmp.Equal(w, g)
+}
+
+// isIntsEqual compares int slices regardless of their order.
+func isIntsEqual(want, got []int) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	w := append([]int{}, want...)
+	g := append([]int{}, got...)
+	sort.Ints(w)
+	sort.Ints(g)
+	return cmp.Equal(w, g)
+}
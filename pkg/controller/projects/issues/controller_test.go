@@ -0,0 +1,541 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issues
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	projectID     = "1234"
+	issueID       = 42
+	issueIID      = 7
+	sIssueIID     = strconv.Itoa(issueIID)
+	title         = "Bootstrap"
+	description   = "Initial issue"
+	issueObj      = &gitlab.Issue{
+		ID:          issueID,
+		IID:         issueIID,
+		Title:       title,
+		Description: description,
+		State:       "opened",
+		Labels:      gitlab.Labels{"b", "a"},
+		Assignees:   []*gitlab.IssueAssignee{{ID: 2}, {ID: 1}},
+		Milestone:   &gitlab.Milestone{ID: 5},
+	}
+)
+
+type args struct {
+	issue projects.IssueClient
+	kube  client.Client
+	cr    resource.Managed
+}
+
+type issueModifier func(*v1alpha1.Issue)
+
+func withConditions(c ...xpv1.Condition) issueModifier {
+	return func(r *v1alpha1.Issue) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.IssueParameters) issueModifier {
+	return func(r *v1alpha1.Issue) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.IssueObservation) issueModifier {
+	return func(r *v1alpha1.Issue) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) issueModifier {
+	return func(r *v1alpha1.Issue) { meta.SetExternalName(r, n) }
+}
+
+func issue(m ...issueModifier) *v1alpha1.Issue {
+	cr := &v1alpha1.Issue{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotIssue),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: issue(),
+			},
+			want: want{
+				cr: issue(),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: issue(withExternalName("fr")),
+			},
+			want: want{
+				cr:  issue(withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: issue(withExternalName(sIssueIID)),
+			},
+			want: want{
+				cr:  issue(withExternalName(sIssueIID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGetRequest": {
+			args: args{
+				issue: &fake.MockClient{
+					MockGetIssue: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 400}}, errBoom
+					},
+				},
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID}),
+				),
+			},
+			want: want{
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID}),
+				),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"ErrGet404": {
+			args: args{
+				issue: &fake.MockClient{
+					MockGetIssue: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID}),
+				),
+			},
+			want: want{
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID}),
+				),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				issue: &fake.MockClient{
+					MockGetIssue: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return issueObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title}),
+				),
+			},
+			want: want{
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{
+						ProjectID:    &projectID,
+						Title:        title,
+						Description:  &description,
+						Confidential: gitlab.Ptr(false),
+					}),
+					withStatus(v1alpha1.IssueObservation{ID: issueID, IID: issueIID, State: "opened"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				issue: &fake.MockClient{
+					MockGetIssue: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return issueObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{
+						ProjectID:    &projectID,
+						Title:        "Another title",
+						Description:  &description,
+						Confidential: gitlab.Ptr(false),
+					}),
+				),
+			},
+			want: want{
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{
+						ProjectID:    &projectID,
+						Title:        "Another title",
+						Description:  &description,
+						Confidential: gitlab.Ptr(false),
+					}),
+					withStatus(v1alpha1.IssueObservation{ID: issueID, IID: issueIID, State: "opened"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.issue}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotIssue),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: issue(),
+			},
+			want: want{
+				cr:  issue(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				issue: &fake.MockClient{
+					MockCreateIssue: func(pid interface{}, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return issueObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: issue(withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title})),
+			},
+			want: want{
+				cr: issue(
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title}),
+					withExternalName(sIssueIID),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				issue: &fake.MockClient{
+					MockCreateIssue: func(pid interface{}, opt *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: issue(withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title})),
+			},
+			want: want{
+				cr:  issue(withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title})),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.issue}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotIssue),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				issue: &fake.MockClient{
+					MockUpdateIssue: func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return issueObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title}),
+				),
+			},
+			want: want{
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title}),
+				),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				issue: &fake.MockClient{
+					MockUpdateIssue: func(pid interface{}, issue int, opt *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title}),
+				),
+			},
+			want: want{
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID, Title: title}),
+				),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.issue}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotIssue),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				issue: &fake.MockClient{
+					MockDeleteIssue: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID}),
+				),
+			},
+			want: want{
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID}),
+				),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				issue: &fake.MockClient{
+					MockDeleteIssue: func(pid interface{}, issue int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID}),
+				),
+			},
+			want: want{
+				cr: issue(
+					withExternalName(sIssueIID),
+					withSpec(v1alpha1.IssueParameters{ProjectID: &projectID}),
+				),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.issue}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsIssueUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.IssueParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: &v1alpha1.IssueParameters{
+				Title:       title,
+				Labels:      []string{"a", "b"},
+				AssigneeIDs: []int{1, 2},
+				MilestoneID: gitlab.Ptr(5),
+			},
+			want: true,
+		},
+		"LabelsChanged": {
+			p: &v1alpha1.IssueParameters{
+				Title:  title,
+				Labels: []string{"a"},
+			},
+			want: false,
+		},
+		"AssigneesChanged": {
+			p: &v1alpha1.IssueParameters{
+				Title:       title,
+				AssigneeIDs: []int{1, 3},
+			},
+			want: false,
+		},
+		"MilestoneChanged": {
+			p: &v1alpha1.IssueParameters{
+				Title:       title,
+				MilestoneID: gitlab.Ptr(6),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, isIssueUpToDate(tc.p, issueObj)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -34,6 +34,9 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/users"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -58,13 +61,15 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{
-			kube:              mgr.GetClient(),
-			newGitlabClientFn: projects.NewMemberClient,
-			newUserClientFn:   users.NewUserClient,
-		}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{
+			kube:               mgr.GetClient(),
+			newGitlabClientFn:  projects.NewMemberClient,
+			newUserClientFn:    users.NewUserClient,
+			newProjectGetterFn: projects.NewProjectGetter,
+		}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -90,9 +95,10 @@ func SetupMember(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg clients.Config) projects.MemberClient
-	newUserClientFn   func(cfg clients.Config) users.UserClient
+	kube               client.Client
+	newGitlabClientFn  func(cfg clients.Config) projects.MemberClient
+	newUserClientFn    func(cfg clients.Config) users.UserClient
+	newProjectGetterFn func(cfg clients.Config) projects.ProjectGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -104,6 +110,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		id, err := projects.ResolveProjectPath(ctx, c.newProjectGetterFn(*cfg), *cr.Spec.ForProvider.ProjectPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.ProjectID = &id
+	}
 	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg), userClient: c.newUserClientFn(*cfg)}, nil
 }
 
@@ -38,7 +38,11 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -66,9 +70,10 @@ func SetupPipelineSchedule(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: newPipelineScheduleClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: newPipelineScheduleClient, newProjectGetterFn: projects.NewProjectGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -99,8 +104,9 @@ type external struct {
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(c clients.Config) projects.PipelineScheduleClient
+	kube               client.Client
+	newGitlabClientFn  func(c clients.Config) projects.PipelineScheduleClient
+	newProjectGetterFn func(c clients.Config) projects.ProjectGetter
 }
 
 // Connect implements managed.ExternalConnecter.
@@ -115,6 +121,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		id, err := projects.ResolveProjectPath(ctx, c.newProjectGetterFn(*conf), *cr.Spec.ForProvider.ProjectPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.ProjectID = clients.StringToPtr(strconv.Itoa(id))
+	}
+
 	return &external{
 		kube:   c.kube,
 		client: c.newGitlabClientFn(*conf),
@@ -133,7 +147,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, nil
 	}
 
-	id, err := strconv.Atoi(idstr)
+	id, err := externalname.ParseID(idstr)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errIDNotAnInt)
 	}
@@ -188,7 +202,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePipelineSchedule)
 	}
 
-	meta.SetExternalName(cr, strconv.Itoa(ps.ID))
+	meta.SetExternalName(cr, externalname.FormatID(ps.ID))
 
 	for _, v := range cr.Spec.ForProvider.Variables {
 		opt := &gitlab.CreatePipelineScheduleVariableOptions{
@@ -219,7 +233,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if extName == "" {
 		return managed.ExternalUpdate{}, errors.New(errExternalNameMissing)
 	}
-	id, err := strconv.Atoi(extName)
+	id, err := externalname.ParseID(extName)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.New(errIDNotAnInt)
 	}
@@ -310,7 +324,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNoProjectID)
 	}
 
-	id, err := strconv.Atoi(meta.GetExternalName(cr))
+	id, err := externalname.ParseID(meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalDelete{}, errors.New(errIDNotAnInt)
 	}
@@ -0,0 +1,429 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectaliases
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	aliasName     = "legacy-name"
+	projectID     = "1234"
+	projectPath   = "group/project"
+	aliasObj      = &projects.ProjectAlias{ID: 7, ProjectID: 1234, Name: aliasName}
+	params        = v1alpha1.ProjectAliasParameters{ProjectID: &projectID, Alias: aliasName}
+)
+
+type args struct {
+	alias projects.ProjectAliasClient
+	kube  client.Client
+	cr    resource.Managed
+}
+
+type aliasModifier func(*v1alpha1.ProjectAlias)
+
+func withConditions(c ...xpv1.Condition) aliasModifier {
+	return func(r *v1alpha1.ProjectAlias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.ProjectAliasParameters) aliasModifier {
+	return func(r *v1alpha1.ProjectAlias) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.ProjectAliasObservation) aliasModifier {
+	return func(r *v1alpha1.ProjectAlias) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) aliasModifier {
+	return func(r *v1alpha1.ProjectAlias) { meta.SetExternalName(r, n) }
+}
+
+func alias(m ...aliasModifier) *v1alpha1.ProjectAlias {
+	cr := &v1alpha1.ProjectAlias{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	pathParams := v1alpha1.ProjectAliasParameters{ProjectID: &projectPath, Alias: aliasName}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotProjectAlias),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: alias(),
+			},
+			want: want{
+				cr: alias(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				alias: &fake.MockClient{
+					MockGetProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errBoom
+					},
+				},
+				cr: alias(withExternalName(aliasName), withSpec(params)),
+			},
+			want: want{
+				cr: alias(withExternalName(aliasName), withSpec(params)),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				alias: &fake.MockClient{
+					MockGetProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}}, errBoom
+					},
+				},
+				cr: alias(withExternalName(aliasName), withSpec(params)),
+			},
+			want: want{
+				cr:  alias(withExternalName(aliasName), withSpec(params)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				alias: &fake.MockClient{
+					MockGetProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+						return aliasObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: alias(withExternalName(aliasName), withSpec(params)),
+			},
+			want: want{
+				cr: alias(
+					withExternalName(aliasName),
+					withSpec(params),
+					withStatus(v1alpha1.ProjectAliasObservation{ID: 7, ProjectID: 1234}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ProjectMoved": {
+			args: args{
+				alias: &fake.MockClient{
+					MockGetProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+						return aliasObj, &gitlab.Response{}, nil
+					},
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return &gitlab.Project{ID: 5678}, &gitlab.Response{}, nil
+					},
+				},
+				cr: alias(withExternalName(aliasName), withSpec(pathParams)),
+			},
+			want: want{
+				cr: alias(
+					withExternalName(aliasName),
+					withSpec(pathParams),
+					withStatus(v1alpha1.ProjectAliasObservation{ID: 7, ProjectID: 1234}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"FailedGetProject": {
+			args: args{
+				alias: &fake.MockClient{
+					MockGetProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+						return aliasObj, &gitlab.Response{}, nil
+					},
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: alias(withExternalName(aliasName), withSpec(pathParams)),
+			},
+			want: want{
+				cr:  alias(withExternalName(aliasName), withSpec(pathParams)),
+				err: errors.Wrap(errBoom, errGetProjectFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.alias}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotProjectAlias),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: alias(),
+			},
+			want: want{
+				cr:  alias(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				alias: &fake.MockClient{
+					MockCreateProjectAlias: func(opt *projects.CreateProjectAliasOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+						return aliasObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: alias(withSpec(params)),
+			},
+			want: want{
+				cr: alias(withSpec(params), withExternalName(aliasName)),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				alias: &fake.MockClient{
+					MockCreateProjectAlias: func(opt *projects.CreateProjectAliasOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: alias(withSpec(params)),
+			},
+			want: want{
+				cr:  alias(withSpec(params)),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.alias}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotProjectAlias),
+			},
+		},
+		"SuccessfulRecreation": {
+			args: args{
+				alias: &fake.MockClient{
+					MockDeleteProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+					MockCreateProjectAlias: func(opt *projects.CreateProjectAliasOptions, options ...gitlab.RequestOptionFunc) (*projects.ProjectAlias, *gitlab.Response, error) {
+						return aliasObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: alias(withExternalName(aliasName), withSpec(params)),
+			},
+			want: want{
+				cr: alias(withExternalName(aliasName), withSpec(params)),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				alias: &fake.MockClient{
+					MockDeleteProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: alias(withExternalName(aliasName), withSpec(params)),
+			},
+			want: want{
+				cr:  alias(withExternalName(aliasName), withSpec(params)),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.alias}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotProjectAlias),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				alias: &fake.MockClient{
+					MockDeleteProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: alias(withExternalName(aliasName)),
+			},
+			want: want{
+				cr: alias(withExternalName(aliasName)),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				alias: &fake.MockClient{
+					MockDeleteProjectAlias: func(name string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: alias(withExternalName(aliasName)),
+			},
+			want: want{
+				cr:  alias(withExternalName(aliasName)),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.alias}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
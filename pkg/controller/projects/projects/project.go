@@ -18,27 +18,36 @@ package projects
 
 import (
 	"context"
-	"strconv"
+	"fmt"
+	"strings"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -49,8 +58,57 @@ const (
 	errUpdateFailed     = "cannot update Gitlab project"
 	errDeleteFailed     = "cannot delete Gitlab project"
 	errGetFailed        = "cannot retrieve Gitlab project with"
+
+	errGetSecuritySettingsFailed    = "cannot retrieve Gitlab project security settings"
+	errUpdateSecuritySettingsFailed = "cannot update Gitlab project security settings"
+	errGetPagesSettingsFailed       = "cannot retrieve Gitlab project Pages settings"
+	errUpdatePagesSettingsFailed    = "cannot update Gitlab project Pages settings"
+	errCreateDefaultBranchFailed    = "cannot create default branch of empty Gitlab project"
+	errHousekeepingFailed           = "cannot start housekeeping of Gitlab project"
+
+	reasonNotUpToDate           event.Reason = "NotUpToDate"
+	reasonDefaultBranchCreated  event.Reason = "DefaultBranchCreated"
+	reasonDefaultBranchDeferred event.Reason = "DefaultBranchDeferred"
+	reasonStorageThreshold      event.Reason = "StorageThresholdExceeded"
+	reasonHousekeepingStarted   event.Reason = "HousekeepingStarted"
+
+	initialCommitMessage = "Initial commit"
+	initialCommitFile    = "README.md"
 )
 
+// TypeStorageThresholdExceeded indicates whether the repository size of a
+// Project exceeds its repositorySizeThreshold.
+const TypeStorageThresholdExceeded xpv1.ConditionType = "StorageThresholdExceeded"
+
+// Reasons a Project is or is not above its storage threshold.
+const (
+	ReasonRepositorySizeExceeded        xpv1.ConditionReason = "RepositorySizeExceeded"
+	ReasonRepositorySizeWithinThreshold xpv1.ConditionReason = "RepositorySizeWithinThreshold"
+)
+
+// StorageThresholdExceeded returns a condition that indicates the repository
+// size of the Project exceeds its threshold.
+func StorageThresholdExceeded(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeStorageThresholdExceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRepositorySizeExceeded,
+		Message:            msg,
+	}
+}
+
+// StorageWithinThreshold returns a condition that indicates the repository
+// size of the Project does not exceed its threshold.
+func StorageWithinThreshold() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeStorageThresholdExceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRepositorySizeWithinThreshold,
+	}
+}
+
 // SetupProject adds a controller that reconciles Projects.
 func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.ProjectKind)
@@ -60,12 +118,21 @@ func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
 	}
 
+	logger := o.Logger.WithValues("controller", name)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewProjectClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{
+			kube:              mgr.GetClient(),
+			logger:            logger,
+			recorder:          recorder,
+			newGitlabClientFn: projects.NewProjectClient,
+		}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithLogger(o.Logger.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithPollIntervalHook(pollinterval.Hook),
+		managed.WithLogger(logger),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...),
 	}
 
@@ -90,6 +157,8 @@ func SetupProject(mgr ctrl.Manager, o controller.Options) error {
 
 type connector struct {
 	kube              client.Client
+	logger            logging.Logger
+	recorder          event.Recorder
 	newGitlabClientFn func(cfg clients.Config) projects.Client
 }
 
@@ -102,12 +171,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+	return &external{kube: c.kube, logger: c.logger, recorder: c.recorder, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client projects.Client
+	kube     client.Client
+	logger   logging.Logger
+	recorder event.Recorder
+	client   projects.Client
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -121,12 +192,19 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	projectID, err := strconv.Atoi(externalName)
+	ref, err := externalname.ParsePathOrID(externalName)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.New(errNotProject)
 	}
+	projectID := ref.PID()
+
+	var opt *gitlab.GetProjectOptions
+	refreshStatistics := projects.IsStatisticsRefreshDue(&cr.Spec.ForProvider, cr.Status.AtProvider.StatisticsRefreshedAt, time.Now())
+	if refreshStatistics {
+		opt = &gitlab.GetProjectOptions{Statistics: gitlab.Ptr(true)}
+	}
 
-	prj, res, err := e.client.GetProject(projectID, nil)
+	prj, res, err := e.client.GetProject(projectID, opt)
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
 			return managed.ExternalObservation{}, nil
@@ -134,16 +212,68 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
 	}
 
+	// A project pending delayed deletion is considered gone if requested, so
+	// that the finalizer does not block until GitLab removes it for good.
+	if meta.WasDeleted(cr) && prj.MarkedForDeletionAt != nil && ptr.Deref(cr.Spec.ForProvider.RemoveFinalizerOnPendingDeletion, false) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A project imported by its path is tracked by its ID from now on, so
+	// that renaming or transferring it does not orphan the resource.
+	if ref.IsPath() {
+		projectID = prj.ID
+		meta.SetExternalName(cr, externalname.FormatID(prj.ID))
+	}
+
 	current := cr.Spec.ForProvider.DeepCopy()
 	lateInitialize(&cr.Spec.ForProvider, prj)
 
+	previous := cr.Status.AtProvider
 	cr.Status.AtProvider = projects.GenerateObservation(prj)
+	cr.Status.AtProvider.Housekeeping = previous.Housekeeping
+	switch {
+	case !refreshStatistics:
+		cr.Status.AtProvider.Statistics = previous.Statistics
+		cr.Status.AtProvider.StatisticsRefreshedAt = previous.StatisticsRefreshedAt
+	case cr.Spec.ForProvider.StatisticsRefreshInterval != nil:
+		now := metav1.Now()
+		cr.Status.AtProvider.StatisticsRefreshedAt = &now
+	}
 	cr.Status.SetConditions(xpv1.Available())
+	e.checkStorageThreshold(cr)
+
+	diff := clients.Diff(&cr.Spec.ForProvider, prj)
+	if len(diff) == 0 && projects.HasSecuritySettings(&cr.Spec.ForProvider) {
+		settings, _, err := e.client.GetProjectSecuritySettings(projectID, gitlab.WithContext(ctx))
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetSecuritySettingsFailed)
+		}
+		if !projects.IsSecuritySettingsUpToDate(&cr.Spec.ForProvider, settings) {
+			diff = append(diff, "securitySettings")
+		}
+	}
+	if projects.IsPagesAvailable(prj) {
+		// The Pages settings are not found until the first deployment, in
+		// which case there is nothing to observe yet.
+		pages, res, err := e.client.GetProjectPagesSettings(projectID, gitlab.WithContext(ctx))
+		switch {
+		case err == nil:
+			projects.LateInitializePagesSettings(&cr.Spec.ForProvider, pages)
+			cr.Status.AtProvider.PagesURL = pages.URL
+			if !projects.IsPagesSettingsUpToDate(&cr.Spec.ForProvider, pages) {
+				diff = append(diff, "pagesSettings")
+			}
+		case !clients.IsResponseNotFound(res):
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetPagesSettingsFailed)
+		}
+	}
+	e.reportDiff(cr, diff)
+	_, housekeepingPending := housekeepingTrigger(cr)
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        isProjectUpToDate(&cr.Spec.ForProvider, prj),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceUpToDate:        len(diff) == 0 && !housekeepingPending,
+		ResourceLateInitialized: ref.IsPath() || !cmp.Equal(current, &cr.Spec.ForProvider),
 		ConnectionDetails:       managed.ConnectionDetails{"runnersToken": []byte(prj.RunnersToken)},
 	}, nil
 }
@@ -159,10 +289,10 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		gitlab.WithContext(ctx),
 	)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+		return managed.ExternalCreation{}, errors.Wrap(clients.RedactError(err), errCreateFailed)
 	}
 
-	meta.SetExternalName(cr, strconv.Itoa(prj.ID))
+	meta.SetExternalName(cr, externalname.FormatID(prj.ID))
 	return managed.ExternalCreation{}, errors.Wrap(err, errKubeUpdateFailed)
 }
 
@@ -172,13 +302,53 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotProject)
 	}
 
+	if err := e.initializeDefaultBranch(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	_, _, err := e.client.EditProject(
 		meta.GetExternalName(cr),
 		projects.GenerateEditProjectOptions(cr.Name, &cr.Spec.ForProvider),
 		gitlab.WithContext(ctx),
 	)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(clients.RedactError(err), errUpdateFailed)
+	}
+
+	if projects.HasSecuritySettings(&cr.Spec.ForProvider) {
+		_, _, err = e.client.UpdateProjectSecuritySettings(
+			meta.GetExternalName(cr),
+			projects.GenerateUpdateProjectSecuritySettingsOptions(&cr.Spec.ForProvider),
+			gitlab.WithContext(ctx),
+		)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSecuritySettingsFailed)
+		}
+	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	if projects.HasPagesSettings(&cr.Spec.ForProvider) {
+		_, _, err = e.client.UpdateProjectPagesSettings(
+			meta.GetExternalName(cr),
+			projects.GenerateUpdateProjectPagesSettingsOptions(&cr.Spec.ForProvider),
+			gitlab.WithContext(ctx),
+		)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePagesSettingsFailed)
+		}
+	}
+
+	if trigger, pending := housekeepingTrigger(cr); pending {
+		if _, err := e.client.StartHousekeepingProject(meta.GetExternalName(cr), gitlab.WithContext(ctx)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errHousekeepingFailed)
+		}
+		cr.Status.AtProvider.Housekeeping = &v1alpha1.HousekeepingObservation{
+			Trigger:       trigger,
+			LastStartTime: &metav1.Time{Time: time.Now()},
+		}
+		e.recorder.Event(cr, event.Normal(reasonHousekeepingStarted, "Successfully requested housekeeping of the project"))
+	}
+
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -191,6 +361,46 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
 }
 
+// initializeDefaultBranch pushes an initial commit to the desired default
+// branch of an empty repository. GitLab ignores a default branch that does
+// not exist, so without a commit the project would never become up to date.
+// Imported and mirrored repositories are left alone, as they are populated
+// by GitLab itself.
+func (e *external) initializeDefaultBranch(ctx context.Context, cr *v1alpha1.Project) error {
+	branch := cr.Spec.ForProvider.DefaultBranch
+	if branch == nil || !cr.Status.AtProvider.EmptyRepo {
+		return nil
+	}
+
+	prj, _, err := e.client.GetProject(meta.GetExternalName(cr), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errGetFailed)
+	}
+	if !prj.EmptyRepo || prj.DefaultBranch == *branch {
+		return nil
+	}
+
+	if prj.ImportURL != "" || prj.Mirror {
+		e.recorder.Event(cr, event.Warning(reasonDefaultBranchDeferred, errors.Errorf("cannot set default branch %q until the imported repository is populated", *branch)))
+		return nil
+	}
+
+	_, _, err = e.client.CreateCommit(prj.ID, &gitlab.CreateCommitOptions{
+		Branch:        branch,
+		CommitMessage: gitlab.Ptr(initialCommitMessage),
+		Actions: []*gitlab.CommitActionOptions{{
+			Action:   gitlab.Ptr(gitlab.FileCreate),
+			FilePath: gitlab.Ptr(initialCommitFile),
+			Content:  gitlab.Ptr("# " + prj.Name + "\n"),
+		}},
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, errCreateDefaultBranchFailed)
+	}
+	e.recorder.Event(cr, event.Normal(reasonDefaultBranchCreated, "Created default branch "+*branch+" with an initial commit"))
+	return nil
+}
+
 func (e *external) Disconnect(ctx context.Context) error {
 	// Disconnect is not implemented as it is a new method required by the SDK
 	return nil
@@ -236,10 +446,19 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 		in.LFSEnabled = &project.LFSEnabled
 	}
 
+	in.MergeCommitTemplate = clients.LateInitializeStringPtr(in.MergeCommitTemplate, project.MergeCommitTemplate)
 	in.MergeMethod = clients.LateInitializeMergeMethodValue(in.MergeMethod, project.MergeMethod)
+
+	if in.MergePipelinesEnabled == nil {
+		in.MergePipelinesEnabled = &project.MergePipelinesEnabled
+	}
+
 	in.MergeRequestsAccessLevel = clients.LateInitializeAccessControlValue(in.MergeRequestsAccessLevel, project.MergeRequestsAccessLevel)
 	in.MergeRequestsTemplate = clients.LateInitializeStringPtr(in.MergeRequestsTemplate, project.MergeRequestsTemplate)
 
+	if in.MergeTrainsEnabled == nil {
+		in.MergeTrainsEnabled = &project.MergeTrainsEnabled
+	}
 	if in.Mirror == nil {
 		in.Mirror = &project.Mirror
 	}
@@ -294,6 +513,8 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 	}
 
 	in.SnippetsAccessLevel = clients.LateInitializeAccessControlValue(in.SnippetsAccessLevel, project.SnippetsAccessLevel)
+	in.SquashCommitTemplate = clients.LateInitializeStringPtr(in.SquashCommitTemplate, project.SquashCommitTemplate)
+	in.SquashOption = clients.LateInitializeSquashOptionValue(in.SquashOption, project.SquashOption)
 	in.SuggestionCommitMessage = clients.LateInitializeStringPtr(in.SuggestionCommitMessage, project.SuggestionCommitMessage)
 
 	if len(in.TagList) == 0 && len(project.TagList) > 0 {
@@ -304,133 +525,44 @@ func lateInitialize(in *v1alpha1.ProjectParameters, project *gitlab.Project) { /
 	in.WikiAccessLevel = clients.LateInitializeAccessControlValue(in.WikiAccessLevel, project.WikiAccessLevel)
 }
 
-// isProjectUpToDate checks whether there is a change in any of the modifiable fields.
-func isProjectUpToDate(p *v1alpha1.ProjectParameters, g *gitlab.Project) bool { //nolint:gocyclo
-	if p.Name != nil && !cmp.Equal(*p.Name, g.Name) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.AllowMergeOnSkippedPipeline, g.AllowMergeOnSkippedPipeline) {
-		return false
-	}
-	if !clients.IsIntEqualToIntPtr(p.ApprovalsBeforeMerge, g.ApprovalsBeforeMerge) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.AutocloseReferencedIssues, g.AutocloseReferencedIssues) {
-		return false
-	}
-	if !cmp.Equal(p.BuildCoverageRegex, clients.StringToPtr(g.BuildCoverageRegex)) {
-		return false
-	}
-	if p.BuildsAccessLevel != nil && !cmp.Equal(string(*p.BuildsAccessLevel), string(g.BuildsAccessLevel)) {
-		return false
-	}
-	if p.CIConfigPath != nil && !cmp.Equal(*p.CIConfigPath, g.CIConfigPath) {
-		return false
-	}
-	if !clients.IsIntEqualToIntPtr(p.CIDefaultGitDepth, g.CIDefaultGitDepth) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.CIForwardDeploymentEnabled, g.CIForwardDeploymentEnabled) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.ContainerRegistryEnabled, g.ContainerRegistryEnabled) {
-		return false
-	}
-	if !cmp.Equal(p.DefaultBranch, clients.StringToPtr(g.DefaultBranch)) {
-		return false
-	}
-	if !cmp.Equal(p.Description, clients.StringToPtr(g.Description)) {
-		return false
-	}
-	if p.ForkingAccessLevel != nil && !cmp.Equal(string(*p.ForkingAccessLevel), string(g.ForkingAccessLevel)) {
-		return false
-	}
-	if p.IssuesAccessLevel != nil && !cmp.Equal(string(*p.IssuesAccessLevel), string(g.IssuesAccessLevel)) {
-		return false
-	}
-	if !cmp.Equal(p.IssuesTemplate, clients.StringToPtr(g.IssuesTemplate)) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.LFSEnabled, g.LFSEnabled) {
-		return false
-	}
-	if p.MergeMethod != nil && !cmp.Equal(string(*p.MergeMethod), string(g.MergeMethod)) {
-		return false
-	}
-	if p.MergeRequestsAccessLevel != nil && !cmp.Equal(string(*p.MergeRequestsAccessLevel), string(g.MergeRequestsAccessLevel)) {
-		return false
-	}
-	if !cmp.Equal(p.MergeRequestsTemplate, clients.StringToPtr(g.MergeRequestsTemplate)) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.Mirror, g.Mirror) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.MirrorOverwritesDivergedBranches, g.MirrorOverwritesDivergedBranches) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.MirrorTriggerBuilds, g.MirrorTriggerBuilds) {
-		return false
-	}
-	if !clients.IsIntEqualToIntPtr(p.MirrorUserID, g.MirrorUserID) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.OnlyAllowMergeIfAllDiscussionsAreResolved, g.OnlyAllowMergeIfAllDiscussionsAreResolved) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.OnlyAllowMergeIfPipelineSucceeds, g.OnlyAllowMergeIfPipelineSucceeds) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.OnlyMirrorProtectedBranches, g.OnlyMirrorProtectedBranches) {
-		return false
-	}
-	if p.OperationsAccessLevel != nil && !cmp.Equal(string(*p.OperationsAccessLevel), string(g.OperationsAccessLevel)) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.PackagesEnabled, g.PackagesEnabled) {
-		return false
-	}
-	if p.PagesAccessLevel != nil && !cmp.Equal(string(*p.PagesAccessLevel), string(g.PagesAccessLevel)) {
-		return false
-	}
-	if !cmp.Equal(p.Path, clients.StringToPtr(g.Path)) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.PublicBuilds, g.PublicJobs) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.RemoveSourceBranchAfterMerge, g.RemoveSourceBranchAfterMerge) {
-		return false
-	}
-	if p.RepositoryAccessLevel != nil && !cmp.Equal(string(*p.RepositoryAccessLevel), string(g.RepositoryAccessLevel)) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.RequestAccessEnabled, g.RequestAccessEnabled) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.ResolveOutdatedDiffDiscussions, g.ResolveOutdatedDiffDiscussions) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.ServiceDeskEnabled, g.ServiceDeskEnabled) {
-		return false
-	}
-	if !clients.IsBoolEqualToBoolPtr(p.SharedRunnersEnabled, g.SharedRunnersEnabled) {
-		return false
-	}
-	if p.SnippetsAccessLevel != nil && !cmp.Equal(string(*p.SnippetsAccessLevel), string(g.SnippetsAccessLevel)) {
-		return false
+// reportDiff logs and records an event listing the fields that are not up to
+// date, so that drift can be diagnosed without comparing the spec by hand.
+func (e *external) reportDiff(cr *v1alpha1.Project, diff []string) {
+	if len(diff) == 0 {
+		return
 	}
-	if !cmp.Equal(p.SuggestionCommitMessage, clients.StringToPtr(g.SuggestionCommitMessage)) {
-		return false
+	e.logger.Debug("Project is not up to date", "name", cr.GetName(), "fields", diff)
+	e.recorder.Event(cr, event.Normal(reasonNotUpToDate, "Fields differ from GitLab: "+strings.Join(diff, ", ")))
+}
+
+// housekeepingTrigger returns the value of the housekeeping trigger annotation
+// and whether it requests a housekeeping task that has not been started yet.
+func housekeepingTrigger(cr *v1alpha1.Project) (string, bool) {
+	trigger := cr.GetAnnotations()[v1alpha1.AnnotationKeyTriggerHousekeeping]
+	if trigger == "" {
+		return "", false
 	}
-	if !cmp.Equal(p.TagList, g.TagList, cmpopts.EquateEmpty()) {
-		return false
+	last := cr.Status.AtProvider.Housekeeping
+	return trigger, last == nil || last.Trigger != trigger
+}
+
+// checkStorageThreshold reports whether the repository size of the project
+// exceeds the threshold given in its spec. The warning event is only recorded
+// when the threshold is crossed, not on every poll.
+func (e *external) checkStorageThreshold(cr *v1alpha1.Project) {
+	threshold := cr.Spec.ForProvider.RepositorySizeThreshold
+	stats := cr.Status.AtProvider.Statistics
+	if threshold == nil || stats == nil {
+		return
 	}
-	if p.Visibility != nil && !cmp.Equal(string(*p.Visibility), string(g.Visibility)) {
-		return false
+	if !projects.IsRepositorySizeAboveThreshold(&cr.Spec.ForProvider, stats) {
+		cr.Status.SetConditions(StorageWithinThreshold())
+		return
 	}
-	if p.WikiAccessLevel != nil && !cmp.Equal(string(*p.WikiAccessLevel), string(g.WikiAccessLevel)) {
-		return false
+
+	msg := fmt.Sprintf("Repository size of %d bytes exceeds the threshold of %s", stats.RepositorySize, threshold.String())
+	if cr.Status.GetCondition(TypeStorageThresholdExceeded).Status != corev1.ConditionTrue {
+		e.recorder.Event(cr, event.Warning(reasonStorageThreshold, errors.New(msg)))
 	}
-	return true
+	cr.Status.SetConditions(StorageThresholdExceeded(msg))
 }
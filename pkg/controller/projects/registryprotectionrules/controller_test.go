@@ -0,0 +1,452 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryprotectionrules
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	projectID     = "1234"
+	ruleID        = 42
+	sRuleID       = strconv.Itoa(ruleID)
+	pattern       = "group/project/release-*"
+	maintainer    = v1alpha1.RegistryProtectionAccessLevelMaintainer
+	owner         = v1alpha1.RegistryProtectionAccessLevelOwner
+	ruleObj       = &projects.RegistryProtectionRule{
+		ID:                          ruleID,
+		ProjectID:                   1234,
+		RepositoryPathPattern:       pattern,
+		MinimumAccessLevelForPush:   "maintainer",
+		MinimumAccessLevelForDelete: "owner",
+	}
+)
+
+type args struct {
+	rule projects.RegistryProtectionRuleClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type ruleModifier func(*v1alpha1.RegistryProtectionRule)
+
+func withConditions(c ...xpv1.Condition) ruleModifier {
+	return func(r *v1alpha1.RegistryProtectionRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.RegistryProtectionRuleParameters) ruleModifier {
+	return func(r *v1alpha1.RegistryProtectionRule) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.RegistryProtectionRuleObservation) ruleModifier {
+	return func(r *v1alpha1.RegistryProtectionRule) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) ruleModifier {
+	return func(r *v1alpha1.RegistryProtectionRule) { meta.SetExternalName(r, n) }
+}
+
+func rule(m ...ruleModifier) *v1alpha1.RegistryProtectionRule {
+	cr := &v1alpha1.RegistryProtectionRule{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotRegistryProtectionRule),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: rule(withExternalName("fr")),
+			},
+			want: want{
+				cr:  rule(withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: rule(withExternalName(sRuleID)),
+			},
+			want: want{
+				cr:  rule(withExternalName(sRuleID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				rule: &fake.MockClient{
+					MockGetRegistryProtectionRule: func(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr:  rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"RuleNotFound": {
+			args: args{
+				rule: &fake.MockClient{
+					MockGetRegistryProtectionRule: func(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, nil
+					},
+				},
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				rule: &fake.MockClient{
+					MockGetRegistryProtectionRule: func(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+						return ruleObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: rule(
+					withExternalName(sRuleID),
+					withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID, RepositoryPathPattern: pattern}),
+				),
+			},
+			want: want{
+				cr: rule(
+					withExternalName(sRuleID),
+					withSpec(v1alpha1.RegistryProtectionRuleParameters{
+						ProjectID:                   &projectID,
+						RepositoryPathPattern:       pattern,
+						MinimumAccessLevelForPush:   &maintainer,
+						MinimumAccessLevelForDelete: &owner,
+					}),
+					withStatus(v1alpha1.RegistryProtectionRuleObservation{ID: ruleID, ProjectID: 1234}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				rule: &fake.MockClient{
+					MockGetRegistryProtectionRule: func(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+						return ruleObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: rule(
+					withExternalName(sRuleID),
+					withSpec(v1alpha1.RegistryProtectionRuleParameters{
+						ProjectID:                   &projectID,
+						RepositoryPathPattern:       pattern,
+						MinimumAccessLevelForPush:   &owner,
+						MinimumAccessLevelForDelete: &owner,
+					}),
+				),
+			},
+			want: want{
+				cr: rule(
+					withExternalName(sRuleID),
+					withSpec(v1alpha1.RegistryProtectionRuleParameters{
+						ProjectID:                   &projectID,
+						RepositoryPathPattern:       pattern,
+						MinimumAccessLevelForPush:   &owner,
+						MinimumAccessLevelForDelete: &owner,
+					}),
+					withStatus(v1alpha1.RegistryProtectionRuleObservation{ID: ruleID, ProjectID: 1234}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rule}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotRegistryProtectionRule),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				rule: &fake.MockClient{
+					MockCreateRegistryProtectionRule: func(pid interface{}, opt *projects.RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+						return ruleObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: rule(withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID, RepositoryPathPattern: pattern})),
+			},
+			want: want{
+				cr: rule(
+					withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID, RepositoryPathPattern: pattern}),
+					withExternalName(sRuleID),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				rule: &fake.MockClient{
+					MockCreateRegistryProtectionRule: func(pid interface{}, opt *projects.RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: rule(withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID, RepositoryPathPattern: pattern})),
+			},
+			want: want{
+				cr:  rule(withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID, RepositoryPathPattern: pattern})),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rule}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotRegistryProtectionRule),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				rule: &fake.MockClient{
+					MockUpdateRegistryProtectionRule: func(pid interface{}, rule int, opt *projects.RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+						return ruleObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				rule: &fake.MockClient{
+					MockUpdateRegistryProtectionRule: func(pid interface{}, rule int, opt *projects.RegistryProtectionRuleOptions, options ...gitlab.RequestOptionFunc) (*projects.RegistryProtectionRule, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr:  rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rule}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotRegistryProtectionRule),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				rule: &fake.MockClient{
+					MockDeleteRegistryProtectionRule: func(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				rule: &fake.MockClient{
+					MockDeleteRegistryProtectionRule: func(pid interface{}, rule int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+			},
+			want: want{
+				cr:  rule(withExternalName(sRuleID), withSpec(v1alpha1.RegistryProtectionRuleParameters{ProjectID: &projectID})),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rule}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitypolicyprojectlinks
+
+import (
+	"context"
+	"strconv"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
+)
+
+const (
+	errNotSecurityPolicyProjectLink   = "managed resource is not a Gitlab security policy project link custom resource"
+	errGetProjectFailed               = "cannot get Gitlab project"
+	errGetPolicyProjectFailed         = "cannot get Gitlab security policy project"
+	errGetFailed                      = "cannot get Gitlab security policy project link"
+	errAssignFailed                   = "cannot assign Gitlab security policy project"
+	errUnassignFailed                 = "cannot unassign Gitlab security policy project"
+	errProjectIDMissing               = "ProjectID is missing"
+	errSecurityPolicyProjectIDMissing = "SecurityPolicyProjectID is missing"
+)
+
+// SetupSecurityPolicyProjectLink adds a controller that reconciles SecurityPolicyProjectLinks.
+func SetupSecurityPolicyProjectLink(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SecurityPolicyProjectLinkKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), secretstoreapi.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewSecurityPolicyProjectLinkClient}))),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.SecurityPolicyProjectLinkGroupVersionKind),
+		reconcilerOpts...)
+
+	if err := mgr.Add(statemetrics.NewMRStateRecorder(
+		mgr.GetClient(), o.Logger, o.MetricOptions.MRStateMetrics, &v1alpha1.SecurityPolicyProjectLinkList{}, o.MetricOptions.PollStateMetricInterval)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.SecurityPolicyProjectLink{}).
+		Complete(r)
+}
+
+type connector struct {
+	kube              client.Client
+	newGitlabClientFn func(cfg clients.Config) projects.SecurityPolicyProjectLinkClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SecurityPolicyProjectLink)
+	if !ok {
+		return nil, errors.New(errNotSecurityPolicyProjectLink)
+	}
+	cfg, err := clients.GetConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client projects.SecurityPolicyProjectLinkClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SecurityPolicyProjectLink)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecurityPolicyProjectLink)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	prj, res, err := e.client.GetProject(externalName, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetProjectFailed)
+	}
+
+	linked, _, err := e.client.GetSecurityPolicyProject(prj.PathWithNamespace, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+	if linked == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = v1alpha1.SecurityPolicyProjectLinkObservation{
+		SecurityPolicyProjectID:       linked.ID,
+		SecurityPolicyProjectFullPath: linked.FullPath,
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isLinkUpToDate(&cr.Spec.ForProvider, linked),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SecurityPolicyProjectLink)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSecurityPolicyProjectLink)
+	}
+
+	if cr.Spec.ForProvider.ProjectID == nil {
+		return managed.ExternalCreation{}, errors.New(errProjectIDMissing)
+	}
+
+	prj, _, err := e.client.GetProject(*cr.Spec.ForProvider.ProjectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetProjectFailed)
+	}
+
+	policyProjectID, err := e.getPolicyProjectID(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if _, err := e.client.AssignSecurityPolicyProject(prj.PathWithNamespace, policyProjectID, gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAssignFailed)
+	}
+
+	meta.SetExternalName(cr, externalname.FormatID(prj.ID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SecurityPolicyProjectLink)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSecurityPolicyProjectLink)
+	}
+
+	prj, _, err := e.client.GetProject(meta.GetExternalName(cr), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetProjectFailed)
+	}
+
+	policyProjectID, err := e.getPolicyProjectID(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// A project can only be linked to a single security policy project, so
+	// the existing link has to be removed first.
+	if _, err := e.client.UnassignSecurityPolicyProject(prj.PathWithNamespace, gitlab.WithContext(ctx)); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUnassignFailed)
+	}
+
+	_, err = e.client.AssignSecurityPolicyProject(prj.PathWithNamespace, policyProjectID, gitlab.WithContext(ctx))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errAssignFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.SecurityPolicyProjectLink)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotSecurityPolicyProjectLink)
+	}
+
+	prj, res, err := e.client.GetProject(meta.GetExternalName(cr), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if clients.IsResponseNotFound(res) {
+			return managed.ExternalDelete{}, nil
+		}
+		return managed.ExternalDelete{}, errors.Wrap(err, errGetProjectFailed)
+	}
+
+	_, err = e.client.UnassignSecurityPolicyProject(prj.PathWithNamespace, gitlab.WithContext(ctx))
+	return managed.ExternalDelete{}, errors.Wrap(err, errUnassignFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Disconnect is not implemented as it is a new method required by the SDK
+	return nil
+}
+
+// getPolicyProjectID returns the numeric ID of the desired security policy
+// project, which may be given as ID or path.
+func (e *external) getPolicyProjectID(ctx context.Context, p *v1alpha1.SecurityPolicyProjectLinkParameters) (int, error) {
+	if p.SecurityPolicyProjectID == nil {
+		return 0, errors.New(errSecurityPolicyProjectIDMissing)
+	}
+	if id, err := strconv.Atoi(*p.SecurityPolicyProjectID); err == nil {
+		return id, nil
+	}
+	prj, _, err := e.client.GetProject(*p.SecurityPolicyProjectID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return 0, errors.Wrap(err, errGetPolicyProjectFailed)
+	}
+	return prj.ID, nil
+}
+
+// isLinkUpToDate checks whether the linked security policy project is the
+// desired one. The desired project may be given as ID or path.
+func isLinkUpToDate(p *v1alpha1.SecurityPolicyProjectLinkParameters, linked *projects.SecurityPolicyProject) bool {
+	if p.SecurityPolicyProjectID == nil {
+		return true
+	}
+	if id, err := strconv.Atoi(*p.SecurityPolicyProjectID); err == nil {
+		return id == linked.ID
+	}
+	return *p.SecurityPolicyProjectID == linked.FullPath
+}
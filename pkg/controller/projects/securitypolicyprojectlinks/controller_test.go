@@ -0,0 +1,439 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitypolicyprojectlinks
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom          = errors.New("boom")
+	unexpecedItem    resource.Managed
+	projectID        = 1234
+	sProjectID       = strconv.Itoa(projectID)
+	projectPath      = "group/project"
+	policyProjectID  = 42
+	sPolicyProjectID = strconv.Itoa(policyProjectID)
+	policyPath       = "group/project-security-policy-project"
+	projectObj       = &gitlab.Project{ID: projectID, PathWithNamespace: projectPath}
+)
+
+type args struct {
+	link projects.SecurityPolicyProjectLinkClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type linkModifier func(*v1alpha1.SecurityPolicyProjectLink)
+
+func withConditions(c ...xpv1.Condition) linkModifier {
+	return func(r *v1alpha1.SecurityPolicyProjectLink) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.SecurityPolicyProjectLinkParameters) linkModifier {
+	return func(r *v1alpha1.SecurityPolicyProjectLink) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.SecurityPolicyProjectLinkObservation) linkModifier {
+	return func(r *v1alpha1.SecurityPolicyProjectLink) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) linkModifier {
+	return func(r *v1alpha1.SecurityPolicyProjectLink) { meta.SetExternalName(r, n) }
+}
+
+func link(m ...linkModifier) *v1alpha1.SecurityPolicyProjectLink {
+	cr := &v1alpha1.SecurityPolicyProjectLink{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	spec := v1alpha1.SecurityPolicyProjectLinkParameters{ProjectID: &sProjectID, SecurityPolicyProjectID: &sPolicyProjectID}
+	getProject := func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+		return projectObj, &gitlab.Response{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotSecurityPolicyProjectLink),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: link(),
+			},
+			want: want{
+				cr: link(),
+			},
+		},
+		"ProjectNotFound": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+			want: want{
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+		},
+		"FailedGetLink": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: getProject,
+					MockGetSecurityPolicyProject: func(fullPath string, options ...gitlab.RequestOptionFunc) (*projects.SecurityPolicyProject, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+			want: want{
+				cr:  link(withExternalName(sProjectID), withSpec(spec)),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"NotLinked": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: getProject,
+					MockGetSecurityPolicyProject: func(fullPath string, options ...gitlab.RequestOptionFunc) (*projects.SecurityPolicyProject, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, nil
+					},
+				},
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+			want: want{
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: getProject,
+					MockGetSecurityPolicyProject: func(fullPath string, options ...gitlab.RequestOptionFunc) (*projects.SecurityPolicyProject, *gitlab.Response, error) {
+						return &projects.SecurityPolicyProject{ID: policyProjectID, FullPath: policyPath}, &gitlab.Response{}, nil
+					},
+				},
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+			want: want{
+				cr: link(
+					withExternalName(sProjectID),
+					withSpec(spec),
+					withStatus(v1alpha1.SecurityPolicyProjectLinkObservation{SecurityPolicyProjectID: policyProjectID, SecurityPolicyProjectFullPath: policyPath}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LinkedToOtherProject": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: getProject,
+					MockGetSecurityPolicyProject: func(fullPath string, options ...gitlab.RequestOptionFunc) (*projects.SecurityPolicyProject, *gitlab.Response, error) {
+						return &projects.SecurityPolicyProject{ID: 7, FullPath: "other/policies"}, &gitlab.Response{}, nil
+					},
+				},
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+			want: want{
+				cr: link(
+					withExternalName(sProjectID),
+					withSpec(spec),
+					withStatus(v1alpha1.SecurityPolicyProjectLinkObservation{SecurityPolicyProjectID: 7, SecurityPolicyProjectFullPath: "other/policies"}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.link}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	getProject := func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+		if pid == policyPath {
+			return &gitlab.Project{ID: policyProjectID, PathWithNamespace: policyPath}, &gitlab.Response{}, nil
+		}
+		return projectObj, &gitlab.Response{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotSecurityPolicyProjectLink),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: link(),
+			},
+			want: want{
+				cr:  link(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SecurityPolicyProjectIDMissing": {
+			args: args{
+				link: &fake.MockClient{MockGetProject: getProject},
+				cr:   link(withSpec(v1alpha1.SecurityPolicyProjectLinkParameters{ProjectID: &projectPath})),
+			},
+			want: want{
+				cr:  link(withSpec(v1alpha1.SecurityPolicyProjectLinkParameters{ProjectID: &projectPath})),
+				err: errors.New(errSecurityPolicyProjectIDMissing),
+			},
+		},
+		"SuccessfulCreationByPath": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: getProject,
+					MockAssignSecurityPolicyProject: func(fullPath string, policyProjectID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						if fullPath != projectPath || policyProjectID != 42 {
+							return nil, errBoom
+						}
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: link(withSpec(v1alpha1.SecurityPolicyProjectLinkParameters{ProjectID: &projectPath, SecurityPolicyProjectID: &policyPath})),
+			},
+			want: want{
+				cr: link(
+					withSpec(v1alpha1.SecurityPolicyProjectLinkParameters{ProjectID: &projectPath, SecurityPolicyProjectID: &policyPath}),
+					withExternalName(sProjectID),
+				),
+			},
+		},
+		"FailedAssign": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: getProject,
+					MockAssignSecurityPolicyProject: func(fullPath string, policyProjectID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: link(withSpec(v1alpha1.SecurityPolicyProjectLinkParameters{ProjectID: &sProjectID, SecurityPolicyProjectID: &sPolicyProjectID})),
+			},
+			want: want{
+				cr:  link(withSpec(v1alpha1.SecurityPolicyProjectLinkParameters{ProjectID: &sProjectID, SecurityPolicyProjectID: &sPolicyProjectID})),
+				err: errors.Wrap(errBoom, errAssignFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.link}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	spec := v1alpha1.SecurityPolicyProjectLinkParameters{ProjectID: &sProjectID, SecurityPolicyProjectID: &sPolicyProjectID}
+	getProject := func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+		return projectObj, &gitlab.Response{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulUpdate": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: getProject,
+					MockUnassignSecurityPolicyProject: func(fullPath string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+					MockAssignSecurityPolicyProject: func(fullPath string, policyProjectID int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+			want: want{
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+		},
+		"FailedUnassign": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: getProject,
+					MockUnassignSecurityPolicyProject: func(fullPath string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: link(withExternalName(sProjectID), withSpec(spec)),
+			},
+			want: want{
+				cr:  link(withExternalName(sProjectID), withSpec(spec)),
+				err: errors.Wrap(errBoom, errUnassignFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.link}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ProjectGone": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: link(withExternalName(sProjectID)),
+			},
+			want: want{
+				cr: link(withExternalName(sProjectID)),
+			},
+		},
+		"FailedUnassign": {
+			args: args{
+				link: &fake.MockClient{
+					MockGetProject: func(pid interface{}, opt *gitlab.GetProjectOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Project, *gitlab.Response, error) {
+						return projectObj, &gitlab.Response{}, nil
+					},
+					MockUnassignSecurityPolicyProject: func(fullPath string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: link(withExternalName(sProjectID)),
+			},
+			want: want{
+				cr:  link(withExternalName(sProjectID)),
+				err: errors.Wrap(errBoom, errUnassignFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.link}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,13 +21,22 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/accesstokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/commits"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploykeys"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/deploytokens"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/featureflags"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/featureflaguserlists"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/hooks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/issues"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/members"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/pipelineschedules"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/projectaliases"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/registryprotectionrules"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/securitypolicyprojectlinks"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/snippets"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/variables"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/projects/wikipages"
 )
 
 // Setup all project controllers
@@ -41,6 +50,15 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		variables.SetupVariable,
 		deploykeys.SetupDeployKey,
 		pipelineschedules.SetupPipelineSchedule,
+		issues.SetupIssue,
+		securitypolicyprojectlinks.SetupSecurityPolicyProjectLink,
+		registryprotectionrules.SetupRegistryProtectionRule,
+		snippets.SetupSnippet,
+		wikipages.SetupWikiPage,
+		commits.SetupCommit,
+		featureflags.SetupFeatureFlag,
+		featureflaguserlists.SetupFeatureFlagUserList,
+		projectaliases.SetupProjectAlias,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -0,0 +1,541 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snippets
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	projectID     = "1234"
+	snippetID     = 42
+	sSnippetID    = strconv.Itoa(snippetID)
+	title         = "Bootstrap script"
+	fileName      = "bootstrap.sh"
+	content       = "echo hello"
+	description   = "Bootstraps things"
+	private       = v1alpha1.PrivateVisibility
+	snippetObj    = &gitlab.Snippet{
+		ID:          snippetID,
+		Title:       title,
+		FileName:    fileName,
+		Description: description,
+		Visibility:  "private",
+		ProjectID:   1234,
+		WebURL:      "https://gitlab.example.com/group/project/-/snippets/42",
+	}
+	secretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "snippet", Namespace: "default"},
+		Key:             "content",
+	}
+)
+
+type args struct {
+	snippet projects.SnippetClient
+	kube    client.Client
+	cr      resource.Managed
+}
+
+type snippetModifier func(*v1alpha1.Snippet)
+
+func withConditions(c ...xpv1.Condition) snippetModifier {
+	return func(r *v1alpha1.Snippet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.SnippetParameters) snippetModifier {
+	return func(r *v1alpha1.Snippet) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.SnippetObservation) snippetModifier {
+	return func(r *v1alpha1.Snippet) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) snippetModifier {
+	return func(r *v1alpha1.Snippet) { meta.SetExternalName(r, n) }
+}
+
+func snippet(m ...snippetModifier) *v1alpha1.Snippet {
+	cr := &v1alpha1.Snippet{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.SnippetParameters {
+	return v1alpha1.SnippetParameters{
+		ProjectID: &projectID,
+		Title:     title,
+		FileName:  fileName,
+		Content:   &content,
+	}
+}
+
+func fullParams() v1alpha1.SnippetParameters {
+	p := params()
+	p.Description = &description
+	p.Visibility = &private
+	return p
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := v1alpha1.SnippetObservation{ID: snippetID, WebURL: snippetObj.WebURL}
+	getSnippet := func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+		return snippetObj, &gitlab.Response{}, nil
+	}
+	snippetContent := func(c string) func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+		return func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+			return []byte(c), &gitlab.Response{}, nil
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotSnippet),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: snippet(),
+			},
+			want: want{
+				cr: snippet(),
+			},
+		},
+		"NotIDExternalName": {
+			args: args{
+				cr: snippet(withExternalName("fr")),
+			},
+			want: want{
+				cr:  snippet(withExternalName("fr")),
+				err: errors.New(errIDNotInt),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: snippet(withExternalName(sSnippetID)),
+			},
+			want: want{
+				cr:  snippet(withExternalName(sSnippetID)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockGetSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+			want: want{
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockGetSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+			want: want{
+				cr:  snippet(withExternalName(sSnippetID), withSpec(params())),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"FailedGetContent": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockGetSnippet: getSnippet,
+					MockSnippetContent: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) ([]byte, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+			want: want{
+				cr:  snippet(withExternalName(sSnippetID), withSpec(params())),
+				err: errors.Wrap(errBoom, errGetContentFailed),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockGetSnippet:     getSnippet,
+					MockSnippetContent: snippetContent(content),
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+			want: want{
+				cr: snippet(
+					withExternalName(sSnippetID),
+					withSpec(fullParams()),
+					withStatus(observation),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ContentNotUpToDate": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockGetSnippet:     getSnippet,
+					MockSnippetContent: snippetContent("echo outdated"),
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(fullParams())),
+			},
+			want: want{
+				cr: snippet(
+					withExternalName(sSnippetID),
+					withSpec(fullParams()),
+					withStatus(observation),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ContentFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"content": []byte(content)}
+						return nil
+					},
+				},
+				snippet: &fake.MockClient{
+					MockGetSnippet:     getSnippet,
+					MockSnippetContent: snippetContent(content),
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(func() v1alpha1.SnippetParameters {
+					p := fullParams()
+					p.Content = nil
+					p.ContentSecretRef = secretRef
+					return p
+				}())),
+			},
+			want: want{
+				cr: snippet(
+					withExternalName(sSnippetID),
+					withSpec(func() v1alpha1.SnippetParameters {
+						p := fullParams()
+						p.Content = nil
+						p.ContentSecretRef = secretRef
+						return p
+					}()),
+					withStatus(observation),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecretKeyNotFound": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				snippet: &fake.MockClient{
+					MockGetSnippet:     getSnippet,
+					MockSnippetContent: snippetContent(content),
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(v1alpha1.SnippetParameters{
+					ProjectID:        &projectID,
+					ContentSecretRef: secretRef,
+				})),
+			},
+			want: want{
+				cr: snippet(withExternalName(sSnippetID), withSpec(v1alpha1.SnippetParameters{
+					ProjectID:        &projectID,
+					ContentSecretRef: secretRef,
+				})),
+				err: errors.New(errSecretKeyNotFound),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snippet}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotSnippet),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: snippet(),
+			},
+			want: want{
+				cr:  snippet(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockCreateSnippet: func(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						if *opt.Content != content {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return snippetObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: snippet(withSpec(params())),
+			},
+			want: want{
+				cr: snippet(
+					withSpec(params()),
+					withExternalName(sSnippetID),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockCreateSnippet: func(pid interface{}, opt *gitlab.CreateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: snippet(withSpec(params())),
+			},
+			want: want{
+				cr:  snippet(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snippet}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotSnippet),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockUpdateSnippet: func(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return snippetObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+			want: want{
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockUpdateSnippet: func(pid interface{}, snippet int, opt *gitlab.UpdateProjectSnippetOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Snippet, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+			want: want{
+				cr:  snippet(withExternalName(sSnippetID), withSpec(params())),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snippet}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotSnippet),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockDeleteSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+			want: want{
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				snippet: &fake.MockClient{
+					MockDeleteSnippet: func(pid interface{}, snippet int, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: snippet(withExternalName(sSnippetID), withSpec(params())),
+			},
+			want: want{
+				cr:  snippet(withExternalName(sSnippetID), withSpec(params())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.snippet}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/statemetrics"
@@ -37,7 +38,11 @@ import (
 	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
 	secretstoreapi "github.com/crossplane-contrib/provider-gitlab/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/externalname"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/dryrun"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/forbidden"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/controller/pollinterval"
 	"github.com/crossplane-contrib/provider-gitlab/pkg/features"
 )
 
@@ -62,9 +67,10 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 	}
 
 	reconcilerOpts := []managed.ReconcilerOption{
-		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewVariableClient}),
+		managed.WithExternalConnecter(dryrun.Connecter(mgr, o, name, forbidden.Connecter(mgr, name, &connector{kube: mgr.GetClient(), newGitlabClientFn: projects.NewVariableClient, newProjectGetterFn: projects.NewProjectGetter}))),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
+		managed.WithPollIntervalHook(pollinterval.Hook),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		managed.WithConnectionPublishers(cps...),
@@ -90,8 +96,9 @@ func SetupVariable(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	kube              client.Client
-	newGitlabClientFn func(cfg clients.Config) projects.VariableClient
+	kube               client.Client
+	newGitlabClientFn  func(cfg clients.Config) projects.VariableClient
+	newProjectGetterFn func(cfg clients.Config) projects.ProjectGetter
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -103,6 +110,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
+	if cr.Spec.ForProvider.ProjectID == nil && cr.Spec.ForProvider.ProjectPath != nil {
+		id, err := projects.ResolveProjectPath(ctx, c.newProjectGetterFn(*cfg), *cr.Spec.ForProvider.ProjectPath)
+		if err != nil {
+			return nil, err
+		}
+		cr.Spec.ForProvider.ProjectID = &id
+	}
 	return &external{kube: c.kube, client: c.newGitlabClientFn(*cfg)}, nil
 }
 
@@ -120,11 +134,21 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errProjectIDMissing)
 	}
 
+	p := observedParameters(cr)
 	variable, res, err := e.client.GetVariable(
 		*cr.Spec.ForProvider.ProjectID,
-		cr.Spec.ForProvider.Key,
-		projects.GenerateGetVariableOptions(&cr.Spec.ForProvider),
+		p.Key,
+		projects.GenerateGetVariableOptions(p),
 		gitlab.WithContext(ctx))
+	if err != nil && clients.IsResponseNotFound(res) && !isSameScope(p, &cr.Spec.ForProvider) {
+		// The environment scope was changed in the spec and the variable
+		// may already have been moved to the new scope.
+		variable, res, err = e.client.GetVariable(
+			*cr.Spec.ForProvider.ProjectID,
+			cr.Spec.ForProvider.Key,
+			projects.GenerateGetVariableOptions(&cr.Spec.ForProvider),
+			gitlab.WithContext(ctx))
+	}
 
 	if err != nil {
 		if clients.IsResponseNotFound(res) {
@@ -141,13 +165,22 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	current := cr.Spec.ForProvider.DeepCopy()
 	projects.LateInitializeVariable(&cr.Spec.ForProvider, variable)
+	lateInitialized := !cmp.Equal(current, &cr.Spec.ForProvider)
 
+	// Resources created before variables were named after their key and
+	// scope are converted to the composite name here.
+	if name := externalname.FormatVariable(variable.Key, &variable.EnvironmentScope); meta.GetExternalName(cr) != name {
+		meta.SetExternalName(cr, name)
+		lateInitialized = true
+	}
+
+	cr.Status.AtProvider = projects.GenerateVariableObservation(variable)
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
 		ResourceUpToDate:        projects.IsVariableUpToDate(&cr.Spec.ForProvider, variable),
-		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ResourceLateInitialized: lateInitialized,
 	}, nil
 }
 
@@ -175,6 +208,8 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 	}
+
+	meta.SetExternalName(cr, externalname.FormatVariable(cr.Spec.ForProvider.Key, cr.Spec.ForProvider.EnvironmentScope))
 	return managed.ExternalCreation{}, nil
 }
 
@@ -193,10 +228,15 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errProjectIDMissing)
 	}
 
+	// The variable is addressed by its current scope, the new one is set
+	// through the options.
+	opt := projects.GenerateUpdateVariableOptions(&cr.Spec.ForProvider)
+	opt.Filter = projects.GenerateVariableFilter(observedParameters(cr))
+
 	_, _, err := e.client.UpdateVariable(
 		*cr.Spec.ForProvider.ProjectID,
 		cr.Spec.ForProvider.Key,
-		projects.GenerateUpdateVariableOptions(&cr.Spec.ForProvider),
+		opt,
 		gitlab.WithContext(ctx),
 	)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
@@ -216,7 +256,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	_, err := e.client.RemoveVariable(
 		*cr.Spec.ForProvider.ProjectID,
 		cr.Spec.ForProvider.Key,
-		projects.GenerateRemoveVariableOptions(&cr.Spec.ForProvider),
+		projects.GenerateRemoveVariableOptions(observedParameters(cr)),
 		gitlab.WithContext(ctx),
 	)
 	return managed.ExternalDelete{}, errors.Wrap(err, errDeleteFailed)
@@ -227,6 +267,21 @@ func (e *external) Disconnect(ctx context.Context) error {
 	return nil
 }
 
+// observedParameters returns the parameters with the environment scope the
+// variable is known under in GitLab, which is recorded in the external name.
+// It differs from the spec while a change of the scope is being applied.
+func observedParameters(cr *v1alpha1.Variable) *v1alpha1.VariableParameters {
+	p := cr.Spec.ForProvider.DeepCopy()
+	if key, scope, err := externalname.ParseVariable(meta.GetExternalName(cr)); err == nil && key == p.Key {
+		p.EnvironmentScope = &scope
+	}
+	return p
+}
+
+func isSameScope(a, b *v1alpha1.VariableParameters) bool {
+	return externalname.FormatVariable(a.Key, a.EnvironmentScope) == externalname.FormatVariable(b.Key, b.EnvironmentScope)
+}
+
 func (e *external) updateVariableFromSecret(ctx context.Context, selector *xpv1.SecretKeySelector, params *v1alpha1.VariableParameters) error {
 	// Fetch the Kubernetes secret.
 	secret := &corev1.Secret{}
@@ -0,0 +1,445 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wikipages
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/xanzy/go-gitlab"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-gitlab/apis/projects/v1alpha1"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects"
+	"github.com/crossplane-contrib/provider-gitlab/pkg/clients/projects/fake"
+)
+
+var (
+	errBoom       = errors.New("boom")
+	unexpecedItem resource.Managed
+	projectID     = "1234"
+	slug          = "getting-started"
+	title         = "Getting started"
+	content       = "Welcome to the wiki."
+	markdown      = v1alpha1.WikiFormatMarkdown
+	wikiPageObj   = &gitlab.Wiki{
+		Title:   title,
+		Slug:    slug,
+		Content: content,
+		Format:  gitlab.WikiFormatMarkdown,
+	}
+)
+
+type args struct {
+	wikiPage projects.WikiPageClient
+	kube     client.Client
+	cr       resource.Managed
+}
+
+type wikiPageModifier func(*v1alpha1.WikiPage)
+
+func withConditions(c ...xpv1.Condition) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(fp v1alpha1.WikiPageParameters) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Spec.ForProvider = fp }
+}
+
+func withStatus(s v1alpha1.WikiPageObservation) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { r.Status.AtProvider = s }
+}
+
+func withExternalName(n string) wikiPageModifier {
+	return func(r *v1alpha1.WikiPage) { meta.SetExternalName(r, n) }
+}
+
+func wikiPage(m ...wikiPageModifier) *v1alpha1.WikiPage {
+	cr := &v1alpha1.WikiPage{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.WikiPageParameters {
+	return v1alpha1.WikiPageParameters{
+		ProjectID: &projectID,
+		Title:     title,
+		Content:   content,
+	}
+}
+
+func fullParams() v1alpha1.WikiPageParameters {
+	p := params()
+	p.Format = &markdown
+	return p
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := v1alpha1.WikiPageObservation{Slug: slug}
+	getWikiPage := func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+		return wikiPageObj, &gitlab.Response{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotWikiPage),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: wikiPage(),
+			},
+			want: want{
+				cr: wikiPage(),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: wikiPage(withExternalName(slug)),
+			},
+			want: want{
+				cr:  wikiPage(withExternalName(slug)),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"NotFound": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockGetWikiPage: func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 404}}, errBoom
+					},
+				},
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+			want: want{
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+		},
+		"FailedGet": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockGetWikiPage: func(pid interface{}, slug string, opt *gitlab.GetWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{Response: &http.Response{StatusCode: 500}}, errBoom
+					},
+				},
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+			want: want{
+				cr:  wikiPage(withExternalName(slug), withSpec(params())),
+				err: errors.Wrap(errBoom, errGetFailed),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockGetWikiPage: getWikiPage,
+				},
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+			want: want{
+				cr: wikiPage(
+					withExternalName(slug),
+					withSpec(fullParams()),
+					withStatus(observation),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ContentNotUpToDate": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockGetWikiPage: getWikiPage,
+				},
+				cr: wikiPage(withExternalName(slug), withSpec(func() v1alpha1.WikiPageParameters {
+					p := fullParams()
+					p.Content = "Outdated."
+					return p
+				}())),
+			},
+			want: want{
+				cr: wikiPage(
+					withExternalName(slug),
+					withSpec(func() v1alpha1.WikiPageParameters {
+						p := fullParams()
+						p.Content = "Outdated."
+						return p
+					}()),
+					withStatus(observation),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.wikiPage}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotWikiPage),
+			},
+		},
+		"ProjectIDMissing": {
+			args: args{
+				cr: wikiPage(),
+			},
+			want: want{
+				cr:  wikiPage(),
+				err: errors.New(errProjectIDMissing),
+			},
+		},
+		"SuccessfulCreation": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockCreateWikiPage: func(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return wikiPageObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withSpec(params())),
+			},
+			want: want{
+				cr: wikiPage(
+					withSpec(params()),
+					withExternalName(slug),
+				),
+			},
+		},
+		"FailedCreation": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockCreateWikiPage: func(pid interface{}, opt *gitlab.CreateWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withSpec(params())),
+			},
+			want: want{
+				cr:  wikiPage(withSpec(params())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.wikiPage}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotWikiPage),
+			},
+		},
+		"SuccessfulUpdate": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockEditWikiPage: func(pid interface{}, s string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						if s != slug {
+							return nil, &gitlab.Response{}, errBoom
+						}
+						return wikiPageObj, &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+			want: want{
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockEditWikiPage: func(pid interface{}, slug string, opt *gitlab.EditWikiPageOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Wiki, *gitlab.Response, error) {
+						return nil, &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+			want: want{
+				cr:  wikiPage(withExternalName(slug), withSpec(params())),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.wikiPage}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errNotWikiPage),
+			},
+		},
+		"SuccessfulDeletion": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockDeleteWikiPage: func(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, nil
+					},
+				},
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+			want: want{
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+		},
+		"FailedDeletion": {
+			args: args{
+				wikiPage: &fake.MockClient{
+					MockDeleteWikiPage: func(pid interface{}, slug string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+						return &gitlab.Response{}, errBoom
+					},
+				},
+				cr: wikiPage(withExternalName(slug), withSpec(params())),
+			},
+			want: want{
+				cr:  wikiPage(withExternalName(slug), withSpec(params())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.wikiPage}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
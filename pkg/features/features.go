@@ -29,4 +29,9 @@ const (
 	// Management Policies. See the below design for more details.
 	// https://github.com/crossplane/crossplane/pull/3531
 	EnableAlphaManagementPolicies feature.Flag = "EnableAlphaManagementPolicies"
+
+	// EnableDryRun makes all controllers observe resources and report the
+	// changes they would make, without creating, updating or deleting
+	// anything in GitLab.
+	EnableDryRun feature.Flag = "EnableDryRun"
 )